@@ -1,6 +1,8 @@
 package drift
 
 import (
+	"context"
+	"errors"
 	"net"
 	"net/http"
 	"time"
@@ -27,27 +29,41 @@ type httpInterface interface {
 }
 
 // Client is the parent struct that contains the miner clients and list of miners to use
+//
+// A Client is safe for concurrent use by multiple goroutines (do not modify the Options once in use)
 type Client struct {
+	flights          flightGroup    // Identical GET requests in-flight (see: ClientOptions.SingleFlight)
 	httpClient       httpInterface  // Interface for all HTTP requests
 	OAuthAccessToken string         // OAuth Access Token (api key)
 	Options          *ClientOptions // Client options config
 }
 
+// RequestModifier can change each request just before it is sent (ie: signing, dynamic headers)
+type RequestModifier func(ctx context.Context, req *http.Request) error
+
 // ClientOptions holds all the configuration for connection, dialer and transport
 type ClientOptions struct {
-	BackOffExponentFactor          float64       `json:"back_off_exponent_factor"`
-	BackOffInitialTimeout          time.Duration `json:"back_off_initial_timeout"`
-	BackOffMaximumJitterInterval   time.Duration `json:"back_off_maximum_jitter_interval"`
-	BackOffMaxTimeout              time.Duration `json:"back_off_max_timeout"`
-	DialerKeepAlive                time.Duration `json:"dialer_keep_alive"`
-	DialerTimeout                  time.Duration `json:"dialer_timeout"`
-	RequestRetryCount              int           `json:"request_retry_count"`
-	RequestTimeout                 time.Duration `json:"request_timeout"`
-	TransportExpectContinueTimeout time.Duration `json:"transport_expect_continue_timeout"`
-	TransportIdleTimeout           time.Duration `json:"transport_idle_timeout"`
-	TransportMaxIdleConnections    int           `json:"transport_max_idle_connections"`
-	TransportTLSHandshakeTimeout   time.Duration `json:"transport_tls_handshake_timeout"`
-	UserAgent                      string        `json:"user_agent"`
+	BackOffExponentFactor          float64         `json:"back_off_exponent_factor"`
+	BackOffInitialTimeout          time.Duration   `json:"back_off_initial_timeout"`
+	BackOffMaximumJitterInterval   time.Duration   `json:"back_off_maximum_jitter_interval"`
+	BackOffMaxTimeout              time.Duration   `json:"back_off_max_timeout"`
+	BatchBudget                    time.Duration   `json:"batch_budget"`       // Total time for a batch helper (ie: BulkUpdateContacts), remaining work is skipped
+	BatchItemBudget                time.Duration   `json:"batch_item_budget"`  // Time for each item in a batch helper
+	CurlDebug                      func(string)    `json:"-"`                  // Receives each request as a curl command (token redacted)
+	DefaultListLimit               int             `json:"default_list_limit"` // Used when a list query has no limit (explicit limit > this > package default)
+	DialerKeepAlive                time.Duration   `json:"dialer_keep_alive"`
+	DialerTimeout                  time.Duration   `json:"dialer_timeout"`
+	DisableRedirects               bool            `json:"disable_redirects"` // Return 3xx responses as-is (ignored by a custom HTTP client with its own CheckRedirect)
+	RequestModifier                RequestModifier `json:"-"`                 // Last-mile changes to each request (an error aborts the request)
+	RequestRetryCount              int             `json:"request_retry_count"`
+	RequestTimeout                 time.Duration   `json:"request_timeout"`
+	SingleFlight                   bool            `json:"single_flight"` // Identical concurrent GET requests share one call (not with a RequestModifier)
+	TransportExpectContinueTimeout time.Duration   `json:"transport_expect_continue_timeout"`
+	TransportIdleTimeout           time.Duration   `json:"transport_idle_timeout"`
+	TransportMaxIdleConnections    int             `json:"transport_max_idle_connections"`
+	TransportTLSHandshakeTimeout   time.Duration   `json:"transport_tls_handshake_timeout"`
+	UserAgent                      string          `json:"user_agent"`
+	ValidateCustomAttributes       bool            `json:"validate_custom_attributes"` // Type-check custom attributes before create/update (one extra request each)
 }
 
 // DefaultClientOptions will return an Options struct with the default settings.
@@ -71,6 +87,12 @@ func DefaultClientOptions() (clientOptions *ClientOptions) {
 }
 
 // NewClient will make a new http client based on the options provided
+//
+// Each client gets its own transport (connection pool), so connections are never shared between clients.
+// A custom HTTP client with a Transport and a CheckRedirect is used as-is. A custom HTTP client without a Transport
+// (ie: &http.Client{}) would share http.DefaultTransport with every client in the process, and one without
+// a CheckRedirect would ignore ClientOptions.DisableRedirects, so a copy of it is used with a dedicated
+// transport and/or the client redirect policy instead (the given client is not changed).
 func NewClient(oAuthAccessToken string, options *ClientOptions, customHTTPClient *http.Client) (c *Client) {
 
 	// Create a client
@@ -87,22 +109,22 @@ func NewClient(oAuthAccessToken string, options *ClientOptions, customHTTPClient
 
 	// Is there a custom HTTP client to use?
 	if customHTTPClient != nil {
+		if customHTTPClient.Transport == nil || customHTTPClient.CheckRedirect == nil {
+			dedicated := *customHTTPClient
+			if dedicated.Transport == nil {
+				dedicated.Transport = newTransport(options)
+			}
+			if dedicated.CheckRedirect == nil {
+				dedicated.CheckRedirect = checkRedirect(options.DisableRedirects)
+			}
+			customHTTPClient = &dedicated
+		}
 		c.httpClient = customHTTPClient
 		return
 	}
 
-	// dial is the net dialer for clientDefaultTransport
-	dial := &net.Dialer{KeepAlive: options.DialerKeepAlive, Timeout: options.DialerTimeout}
-
-	// clientDefaultTransport is the default transport struct for the HTTP client
-	clientDefaultTransport := &http.Transport{
-		DialContext:           dial.DialContext,
-		ExpectContinueTimeout: options.TransportExpectContinueTimeout,
-		IdleConnTimeout:       options.TransportIdleTimeout,
-		MaxIdleConns:          options.TransportMaxIdleConnections,
-		Proxy:                 http.ProxyFromEnvironment,
-		TLSHandshakeTimeout:   options.TransportTLSHandshakeTimeout,
-	}
+	// clientDefaultTransport is the dedicated transport for this client (never shared)
+	clientDefaultTransport := newTransport(options)
 
 	// Determine the strategy for the http client
 	if options.RequestRetryCount <= 0 {
@@ -111,8 +133,9 @@ func NewClient(oAuthAccessToken string, options *ClientOptions, customHTTPClient
 		c.httpClient = httpclient.NewClient(
 			httpclient.WithHTTPTimeout(options.RequestTimeout),
 			httpclient.WithHTTPClient(&http.Client{
-				Transport: clientDefaultTransport,
-				Timeout:   options.RequestTimeout,
+				CheckRedirect: checkRedirect(options.DisableRedirects),
+				Transport:     clientDefaultTransport,
+				Timeout:       options.RequestTimeout,
 			}),
 		)
 		return
@@ -130,10 +153,47 @@ func NewClient(oAuthAccessToken string, options *ClientOptions, customHTTPClient
 			))),
 		httpclient.WithRetryCount(options.RequestRetryCount),
 		httpclient.WithHTTPClient(&http.Client{
-			Transport: clientDefaultTransport,
-			Timeout:   options.RequestTimeout,
+			CheckRedirect: checkRedirect(options.DisableRedirects),
+			Transport:     clientDefaultTransport,
+			Timeout:       options.RequestTimeout,
 		}),
 	)
 
 	return
 }
+
+// newTransport will create a transport based on the options provided
+func newTransport(options *ClientOptions) *http.Transport {
+
+	// dial is the net dialer for the transport
+	dial := &net.Dialer{KeepAlive: options.DialerKeepAlive, Timeout: options.DialerTimeout}
+
+	// All requests go to the same host, so allow all idle connections for that host
+	// (the default is only http.DefaultMaxIdleConnsPerHost)
+	return &http.Transport{
+		DialContext:           dial.DialContext,
+		ExpectContinueTimeout: options.TransportExpectContinueTimeout,
+		IdleConnTimeout:       options.TransportIdleTimeout,
+		MaxIdleConns:          options.TransportMaxIdleConnections,
+		MaxIdleConnsPerHost:   options.TransportMaxIdleConnections,
+		Proxy:                 http.ProxyFromEnvironment,
+		TLSHandshakeTimeout:   options.TransportTLSHandshakeTimeout,
+	}
+}
+
+// checkRedirect will record each redirect in the request's history (see: RequestResponse.RedirectHistory)
+// or stop following redirects if disabled (the 3xx response is returned as-is)
+func checkRedirect(disabled bool) func(req *http.Request, via []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if disabled {
+			return http.ErrUseLastResponse
+		}
+		if history, ok := req.Context().Value(redirectHistoryKey{}).(*[]string); ok {
+			*history = append(*history, req.URL.String())
+		}
+		if len(via) >= 10 { // Same limit as the default http client
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+}
@@ -1,10 +1,16 @@
 package drift
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -17,6 +23,7 @@ const (
 	testContactPhone          = "15554443333"
 	testDataOAuthToken        = "testKey1234567"
 	testEventName             = "test-event-name-goes-here"
+	testExternalID            = "ext-123"
 )
 
 // newTestClient returns a client for mocking (using a custom HTTP interface)
@@ -131,3 +138,216 @@ func TestClientDefaultOptions_NoRetry(t *testing.T) {
 		t.Errorf("user agent mismatch")
 	}
 }
+
+// TestClient_Redirects tests following (or not following) redirects with the default HTTP client
+func TestClient_Redirects(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/new", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Run("redirect history is recorded", func(t *testing.T) {
+		client := NewClient(testDataOAuthToken, nil, nil)
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            server.URL + "/old",
+		})
+		assert.NoError(t, response.Error)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, []string{server.URL + "/new"}, response.RedirectHistory)
+	})
+
+	t.Run("no redirects", func(t *testing.T) {
+		client := NewClient(testDataOAuthToken, nil, nil)
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            server.URL + "/new",
+		})
+		assert.NoError(t, response.Error)
+		assert.Equal(t, 0, len(response.RedirectHistory))
+	})
+
+	t.Run("redirects disabled", func(t *testing.T) {
+		options := DefaultClientOptions()
+		options.DisableRedirects = true
+		client := NewClient(testDataOAuthToken, options, nil)
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            server.URL + "/old",
+		})
+		assert.ErrorIs(t, response.Error, ErrUnexpectedStatus)
+		assert.Equal(t, http.StatusMovedPermanently, response.StatusCode)
+		assert.Equal(t, 0, len(response.RedirectHistory))
+	})
+
+	t.Run("custom client without a redirect policy", func(t *testing.T) {
+		options := DefaultClientOptions()
+		options.DisableRedirects = true
+		client := NewClient(testDataOAuthToken, options, &http.Client{Transport: &http.Transport{}})
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            server.URL + "/old",
+		})
+		assert.ErrorIs(t, response.Error, ErrUnexpectedStatus)
+		assert.Equal(t, http.StatusMovedPermanently, response.StatusCode)
+	})
+
+	t.Run("custom client redirect history", func(t *testing.T) {
+		client := NewClient(testDataOAuthToken, nil, &http.Client{})
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            server.URL + "/old",
+		})
+		assert.NoError(t, response.Error)
+		assert.Equal(t, []string{server.URL + "/new"}, response.RedirectHistory)
+	})
+}
+
+// TestNewTransport tests the transport created for each client
+func TestNewTransport(t *testing.T) {
+	t.Parallel()
+
+	options := DefaultClientOptions()
+	transport := newTransport(options)
+	assert.Equal(t, options.TransportMaxIdleConnections, transport.MaxIdleConns)
+	assert.Equal(t, options.TransportMaxIdleConnections, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, options.TransportIdleTimeout, transport.IdleConnTimeout)
+	assert.Equal(t, options.TransportTLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+	assert.Equal(t, options.TransportExpectContinueTimeout, transport.ExpectContinueTimeout)
+
+	// Never shared between clients
+	assert.NotSame(t, transport, newTransport(options))
+}
+
+// TestNewClient_CustomTransport tests the transport used with a custom HTTP client
+func TestNewClient_CustomTransport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no transport gets a dedicated transport", func(t *testing.T) {
+		custom := &http.Client{Timeout: 5 * time.Second}
+		client := NewClient(testDataOAuthToken, nil, custom)
+
+		httpClient, ok := client.httpClient.(*http.Client)
+		assert.True(t, ok)
+		assert.NotSame(t, custom, httpClient)
+		assert.Equal(t, custom.Timeout, httpClient.Timeout)
+		assert.Nil(t, custom.Transport)
+
+		transport, ok := httpClient.Transport.(*http.Transport)
+		assert.True(t, ok)
+		assert.Equal(t, client.Options.TransportMaxIdleConnections, transport.MaxIdleConnsPerHost)
+
+		other := NewClient(testDataOAuthToken, nil, custom).httpClient.(*http.Client)
+		assert.NotSame(t, transport, other.Transport)
+	})
+
+	t.Run("custom transport and redirect policy are used as-is", func(t *testing.T) {
+		custom := &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error { return nil },
+			Transport:     &http.Transport{},
+		}
+		client := NewClient(testDataOAuthToken, nil, custom)
+		assert.Same(t, custom, client.httpClient)
+	})
+
+	t.Run("custom transport without a redirect policy", func(t *testing.T) {
+		custom := &http.Client{Transport: &http.Transport{}}
+		client := NewClient(testDataOAuthToken, nil, custom)
+
+		httpClient, ok := client.httpClient.(*http.Client)
+		assert.True(t, ok)
+		assert.NotSame(t, custom, httpClient)
+		assert.Same(t, custom.Transport, httpClient.Transport)
+		assert.NotNil(t, httpClient.CheckRedirect)
+		assert.Nil(t, custom.CheckRedirect)
+	})
+}
+
+// mockHTTPConcurrent for mocking all the requests made by a single client
+type mockHTTPConcurrent struct{}
+
+// Do is a mock http request (routes to the mock for each endpoint)
+func (m *mockHTTPConcurrent) Do(req *http.Request) (*http.Response, error) {
+	switch {
+	case req.URL.Path == "/contacts/attributes":
+		return (&mockHTTPCustomAttributes{body: testCustomAttributesJSON, statusCode: http.StatusOK}).Do(req)
+	case req.URL.Path == "/contacts/timeline":
+		return (&mockHTTPTimelineEvents{}).Do(req)
+	case req.Method == http.MethodPatch:
+		return (&mockHTTPUpdateContact{}).Do(req)
+	case req.Method == http.MethodPost:
+		return (&mockHTTPCreateContact{}).Do(req)
+	}
+	return (&mockHTTPGetContacts{}).Do(req)
+}
+
+// TestClient_Concurrent tests using a single client from many goroutines (run with -race)
+func TestClient_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	client := newTestAttributesClient(&mockHTTPConcurrent{})
+	id, err := strconv.ParseUint(testContactID, 10, 64)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50*5)
+	for i := 0; i < 50; i++ {
+		wg.Add(5)
+		go func() {
+			defer wg.Done()
+			_, getErr := client.GetContacts(context.Background(), &ContactQuery{ID: testContactID})
+			errs <- getErr
+		}()
+		go func() {
+			defer wg.Done()
+			_, createErr := client.CreateContact(context.Background(), &ContactFields{
+				Attributes:       &StandardAttributes{Email: testContactEmail},
+				CustomAttributes: map[string]interface{}{"employees": 25},
+			})
+			errs <- createErr
+		}()
+		go func() {
+			defer wg.Done()
+			_, updateErr := client.UpdateContact(context.Background(), id, &ContactFields{
+				Attributes: &StandardAttributes{Name: testContactName + "2"},
+			})
+			errs <- updateErr
+		}()
+		go func() {
+			defer wg.Done()
+			_, listErr := client.ListCustomAttributes(context.Background())
+			errs <- listErr
+		}()
+		go func() {
+			defer wg.Done()
+			_, eventErr := client.CreateTimelineEvent(context.Background(), &TimelineEvent{
+				ContactID: id,
+				Event:     testEventName,
+			})
+			errs <- eventErr
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err = range errs {
+		assert.NoError(t, err)
+	}
+}
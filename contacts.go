@@ -1,5 +1,7 @@
 package drift
 
+import "encoding/json"
+
 // Contact is the base contact model
 type Contact struct {
 	Data *contactData `json:"data"`
@@ -17,9 +19,46 @@ type contactData struct {
 	ID         uint64      `json:"id"`
 }
 
-// ContactFields is used for creating/updating a contact (standard attributes)
+// ContactFields is used for creating/updating a contact (standard & custom attributes)
 type ContactFields struct {
-	Attributes *StandardAttributes `json:"attributes"`
+	Attributes       *StandardAttributes    `json:"attributes"`
+	CustomAttributes map[string]interface{} `json:"-"` // Keyed by the attribute name (see: ListCustomAttributes)
+}
+
+// Validate will check the fields before creating/updating a contact
+func (f *ContactFields) Validate() error {
+	if f == nil || (f.Attributes == nil && len(f.CustomAttributes) == 0) {
+		return ErrMissingContactAttributes
+	}
+	return nil
+}
+
+// MarshalJSON will merge the standard and custom attributes into a single attributes object
+func (f ContactFields) MarshalJSON() ([]byte, error) {
+
+	// No custom attributes, keep the standard format
+	if len(f.CustomAttributes) == 0 {
+		return json.Marshal(map[string]*StandardAttributes{"attributes": f.Attributes})
+	}
+
+	// Custom values first, standard attributes always take precedence
+	merged := make(map[string]interface{}, len(f.CustomAttributes)+3)
+	for name, value := range f.CustomAttributes {
+		merged[name] = value
+	}
+	if f.Attributes != nil {
+		if len(f.Attributes.Email) > 0 {
+			merged["email"] = f.Attributes.Email
+		}
+		if len(f.Attributes.Name) > 0 {
+			merged["name"] = f.Attributes.Name
+		}
+		if len(f.Attributes.Phone) > 0 {
+			merged["phone"] = f.Attributes.Phone
+		}
+	}
+
+	return json.Marshal(map[string]interface{}{"attributes": merged})
 }
 
 // StandardAttributes are used to create new contacts
@@ -56,4 +95,5 @@ type attributes struct {
 	RecentSource                         string                 `json:"recent_source"`
 	SocialProfiles                       map[string]interface{} `json:"social_profiles"`
 	StartDate                            int                    `json:"start_date"`
+	Tags                                 []*ContactTag          `json:"tags"`
 }
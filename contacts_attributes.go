@@ -0,0 +1,168 @@
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Custom attribute types (the types that can be checked locally)
+const (
+	AttributeTypeBoolean = "BOOLEAN"
+	AttributeTypeEmail   = "EMAIL"
+	AttributeTypeNumber  = "NUMBER"
+	AttributeTypePhone   = "PHONE"
+	AttributeTypeString  = "STRING"
+	AttributeTypeURL     = "URL"
+)
+
+// CustomAttributes is the response from listing the custom attributes
+type CustomAttributes struct {
+	Data *CustomAttributesData `json:"data"`
+}
+
+// CustomAttributesData is the list of attribute definitions
+type CustomAttributesData struct {
+	Properties []*AttributeProperty `json:"properties"`
+}
+
+// AttributeProperty is the definition of a single contact attribute
+type AttributeProperty struct {
+	DisplayName string `json:"displayName"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+}
+
+// ListCustomAttributes will fire the HTTP request to list all the contact attribute definitions
+// specs: https://devdocs.drift.com/docs/listing-custom-attributes
+func (c *Client) ListCustomAttributes(ctx context.Context) (attributes *CustomAttributes, err error) {
+
+	// Create and fire the request
+	var response *RequestResponse
+	if response, err = c.ListCustomAttributesRaw(ctx); err != nil {
+		return
+	}
+
+	// Parse the request
+	attributes = new(CustomAttributes)
+	if err = decodeResponse(response.BodyContents, &attributes); err != nil {
+		attributes = nil
+		return
+	}
+
+	// Always return a (possibly empty) list
+	if attributes.Data == nil {
+		attributes.Data = new(CustomAttributesData)
+	}
+	if attributes.Data.Properties == nil {
+		attributes.Data.Properties = []*AttributeProperty{}
+	}
+	return
+}
+
+// ListCustomAttributesRaw will fire the HTTP request to retrieve the raw attribute definitions
+// specs: https://devdocs.drift.com/docs/listing-custom-attributes
+func (c *Client) ListCustomAttributesRaw(ctx context.Context) (response *RequestResponse, err error) {
+	if response = httpRequest(
+		ctx, c, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            apiEndpoint + "/contacts/attributes",
+		},
+	); response.Error != nil {
+		err = response.Error
+	}
+	return
+}
+
+// validateCustomAttributes will check the custom attribute values against the attribute definitions
+// (only if the client option ValidateCustomAttributes is enabled)
+//
+// The definitions are not cached, so each create/update makes an extra ListCustomAttributes request
+func (c *Client) validateCustomAttributes(ctx context.Context, fields *ContactFields) error {
+
+	// Nothing to validate
+	if !c.Options.ValidateCustomAttributes || fields == nil || len(fields.CustomAttributes) == 0 {
+		return nil
+	}
+
+	// Get the current definitions
+	definitions, err := c.ListCustomAttributes(ctx)
+	if err != nil {
+		return err
+	}
+	types := make(map[string]string, len(definitions.Data.Properties))
+	for _, property := range definitions.Data.Properties {
+		if property != nil {
+			types[property.Name] = property.Type
+		}
+	}
+
+	// Check each value
+	for name, value := range fields.CustomAttributes {
+		attributeType, ok := types[name]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownCustomAttribute, name)
+		}
+		if !isValidAttributeValue(attributeType, value) {
+			return fmt.Errorf(
+				"%w: %s expects type %s but got %T",
+				ErrInvalidCustomAttribute, name, attributeType, value,
+			)
+		}
+	}
+	return nil
+}
+
+// isValidAttributeValue will return false if the value does not match the attribute type
+// (types that cannot be checked locally are always valid)
+func isValidAttributeValue(attributeType string, value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	switch attributeType {
+	case AttributeTypeString, AttributeTypeEmail, AttributeTypePhone, AttributeTypeURL:
+		_, ok := value.(string)
+		return ok
+	case AttributeTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case AttributeTypeNumber:
+		switch value.(type) {
+		case int, int8, int16, int32, int64,
+			uint, uint8, uint16, uint32, uint64,
+			float32, float64, json.Number:
+			return true
+		}
+		return false
+	}
+	return true
+}
+
+// FilterByType will return the attribute definitions of the given type (ie: AttributeTypeNumber)
+func (d *CustomAttributesData) FilterByType(attributeType string) []*AttributeProperty {
+	properties := []*AttributeProperty{}
+	if d == nil {
+		return properties
+	}
+	for _, property := range d.Properties {
+		if property != nil && property.Type == attributeType {
+			properties = append(properties, property)
+		}
+	}
+	return properties
+}
+
+// ByName will return the attribute definition for the given attribute name
+func (d *CustomAttributesData) ByName(name string) (*AttributeProperty, bool) {
+	if d == nil {
+		return nil, false
+	}
+	for _, property := range d.Properties {
+		if property != nil && property.Name == name {
+			return property, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,258 @@
+package drift
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCustomAttributesJSON = `{"data":{"properties":[{"name":"employees","displayName":"Employees","type":"NUMBER"},{"name":"plan","displayName":"Plan","type":"STRING"},{"name":"is_customer","displayName":"Is Customer","type":"BOOLEAN"},{"name":"start_date","displayName":"Start Date","type":"DATETIME"}]}}`
+
+// mockHTTPCustomAttributes for mocking requests
+type mockHTTPCustomAttributes struct {
+	body       string
+	statusCode int
+}
+
+// Do is a mock http request
+func (m *mockHTTPCustomAttributes) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, fmt.Errorf("missing request")
+	}
+
+	// Attribute definitions
+	if req.URL.String() == apiEndpoint+"/contacts/attributes" {
+		resp.StatusCode = m.statusCode
+		resp.Body = ioutil.NopCloser(bytes.NewBuffer([]byte(m.body)))
+	} else if req.URL.String() == apiEndpoint+"/contacts" || req.URL.String() == apiEndpoint+"/contacts/"+testContactID {
+		resp.StatusCode = http.StatusOK
+		resp.Body = ioutil.NopCloser(bytes.NewBuffer([]byte(`{"data":{"id":` + testContactID + `,"createdAt":1614563742010,"attributes":{"name":"` + testContactName + `","email":"` + testContactEmail + `","employees":25}}}`)))
+	}
+
+	// Default is valid
+	return resp, nil
+}
+
+// newTestAttributesClient returns a client with custom attribute validation enabled
+func newTestAttributesClient(httpClient httpInterface) *Client {
+	options := DefaultClientOptions()
+	options.ValidateCustomAttributes = true
+	client := NewClient(testDataOAuthToken, options, nil)
+	client.httpClient = httpClient
+	return client
+}
+
+// TestClient_ListCustomAttributes tests the method ListCustomAttributes()
+func TestClient_ListCustomAttributes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("list the attribute definitions", func(t *testing.T) {
+		client := newTestClient(&mockHTTPCustomAttributes{body: testCustomAttributesJSON, statusCode: http.StatusOK})
+
+		attributes, err := client.ListCustomAttributes(context.Background())
+		assert.NoError(t, err)
+		assert.NotNil(t, attributes)
+		assert.Equal(t, 4, len(attributes.Data.Properties))
+		assert.Equal(t, "employees", attributes.Data.Properties[0].Name)
+		assert.Equal(t, "Employees", attributes.Data.Properties[0].DisplayName)
+		assert.Equal(t, AttributeTypeNumber, attributes.Data.Properties[0].Type)
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		client := newTestClient(&mockHTTPCustomAttributes{body: `{"data":{}}`, statusCode: http.StatusOK})
+
+		attributes, err := client.ListCustomAttributes(context.Background())
+		assert.NoError(t, err)
+		assert.NotNil(t, attributes)
+		assert.NotNil(t, attributes.Data.Properties)
+		assert.Equal(t, 0, len(attributes.Data.Properties))
+	})
+
+	t.Run("unauthorized response", func(t *testing.T) {
+		client := newTestClient(&mockHTTPCustomAttributes{statusCode: http.StatusUnauthorized})
+
+		attributes, err := client.ListCustomAttributes(context.Background())
+		assert.ErrorIs(t, err, ErrUnauthorized)
+		assert.Nil(t, attributes)
+	})
+
+	t.Run("bad json response", func(t *testing.T) {
+		client := newTestClient(&mockHTTPCustomAttributes{body: `{"data":{"properties":[{"name"}]}}`, statusCode: http.StatusOK})
+
+		attributes, err := client.ListCustomAttributes(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, attributes)
+	})
+}
+
+// TestCustomAttributesData_FilterByType tests the method FilterByType()
+func TestCustomAttributesData_FilterByType(t *testing.T) {
+	t.Parallel()
+
+	attributes := new(CustomAttributes)
+	err := json.Unmarshal([]byte(testCustomAttributesJSON), &attributes)
+	assert.NoError(t, err)
+
+	t.Run("filter by number", func(t *testing.T) {
+		properties := attributes.Data.FilterByType(AttributeTypeNumber)
+		assert.Equal(t, 1, len(properties))
+		assert.Equal(t, "employees", properties[0].Name)
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		properties := attributes.Data.FilterByType(AttributeTypeURL)
+		assert.NotNil(t, properties)
+		assert.Equal(t, 0, len(properties))
+	})
+
+	t.Run("nil data", func(t *testing.T) {
+		var data *CustomAttributesData
+		properties := data.FilterByType(AttributeTypeNumber)
+		assert.NotNil(t, properties)
+		assert.Equal(t, 0, len(properties))
+	})
+}
+
+// TestCustomAttributesData_ByName tests the method ByName()
+func TestCustomAttributesData_ByName(t *testing.T) {
+	t.Parallel()
+
+	attributes := new(CustomAttributes)
+	err := json.Unmarshal([]byte(testCustomAttributesJSON), &attributes)
+	assert.NoError(t, err)
+
+	t.Run("found", func(t *testing.T) {
+		property, ok := attributes.Data.ByName("plan")
+		assert.True(t, ok)
+		assert.Equal(t, AttributeTypeString, property.Type)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		property, ok := attributes.Data.ByName("unknown")
+		assert.False(t, ok)
+		assert.Nil(t, property)
+	})
+
+	t.Run("nil data", func(t *testing.T) {
+		var data *CustomAttributesData
+		property, ok := data.ByName("plan")
+		assert.False(t, ok)
+		assert.Nil(t, property)
+	})
+}
+
+// TestContactFields_MarshalJSON tests the method MarshalJSON()
+func TestContactFields_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard attributes only", func(t *testing.T) {
+		data, err := json.Marshal(&ContactFields{Attributes: &StandardAttributes{Name: testContactName}})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"attributes":{"name":"`+testContactName+`"}}`, string(data))
+	})
+
+	t.Run("custom attributes are merged", func(t *testing.T) {
+		data, err := json.Marshal(&ContactFields{
+			Attributes:       &StandardAttributes{Email: testContactEmail},
+			CustomAttributes: map[string]interface{}{"employees": 25, "is_customer": true},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"attributes":{"email":"`+testContactEmail+`","employees":25,"is_customer":true}}`, string(data))
+	})
+
+	t.Run("standard attributes take precedence", func(t *testing.T) {
+		data, err := json.Marshal(&ContactFields{
+			Attributes:       &StandardAttributes{Name: testContactName},
+			CustomAttributes: map[string]interface{}{"name": "other"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"attributes":{"name":"`+testContactName+`"}}`, string(data))
+	})
+}
+
+// TestClient_validateCustomAttributes tests creating/updating with custom attribute validation
+func TestClient_validateCustomAttributes(t *testing.T) {
+	t.Parallel()
+
+	mock := &mockHTTPCustomAttributes{body: testCustomAttributesJSON, statusCode: http.StatusOK}
+
+	t.Run("valid custom attributes", func(t *testing.T) {
+		client := newTestAttributesClient(mock)
+
+		contact, err := client.CreateContact(context.Background(), &ContactFields{
+			Attributes: &StandardAttributes{Email: testContactEmail},
+			CustomAttributes: map[string]interface{}{
+				"employees":   25,
+				"is_customer": true,
+				"plan":        "enterprise",
+				"start_date":  1614563742010,
+			},
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, contact)
+	})
+
+	t.Run("wrong type for a number", func(t *testing.T) {
+		client := newTestAttributesClient(mock)
+
+		id, err := strconv.ParseUint(testContactID, 10, 64)
+		assert.NoError(t, err)
+
+		var contact *Contact
+		contact, err = client.UpdateContact(context.Background(), id, &ContactFields{
+			CustomAttributes: map[string]interface{}{"employees": "25"},
+		})
+		assert.ErrorIs(t, err, ErrInvalidCustomAttribute)
+		assert.Contains(t, err.Error(), "employees")
+		assert.Nil(t, contact)
+	})
+
+	t.Run("unknown custom attribute", func(t *testing.T) {
+		client := newTestAttributesClient(mock)
+
+		contact, err := client.CreateContact(context.Background(), &ContactFields{
+			CustomAttributes: map[string]interface{}{"unknown": "value"},
+		})
+		assert.ErrorIs(t, err, ErrUnknownCustomAttribute)
+		assert.Nil(t, contact)
+	})
+
+	t.Run("validation disabled sends values as-is", func(t *testing.T) {
+		client := newTestClient(mock)
+
+		contact, err := client.CreateContact(context.Background(), &ContactFields{
+			CustomAttributes: map[string]interface{}{"employees": "25"},
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, contact)
+	})
+
+	t.Run("failed to list the definitions", func(t *testing.T) {
+		client := newTestAttributesClient(&mockHTTPCustomAttributes{statusCode: http.StatusUnauthorized})
+
+		contact, err := client.CreateContact(context.Background(), &ContactFields{
+			CustomAttributes: map[string]interface{}{"employees": 25},
+		})
+		assert.ErrorIs(t, err, ErrUnauthorized)
+		assert.Nil(t, contact)
+	})
+}
+
+// BenchmarkClient_ListCustomAttributes benchmarks the ListCustomAttributes method
+func BenchmarkClient_ListCustomAttributes(b *testing.B) {
+	client := newTestClient(&mockHTTPCustomAttributes{body: testCustomAttributesJSON, statusCode: http.StatusOK})
+	for i := 0; i < b.N; i++ {
+		_, _ = client.ListCustomAttributes(context.Background())
+	}
+}
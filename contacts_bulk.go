@@ -0,0 +1,170 @@
+package drift
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+const (
+
+	// bulkConcurrency is the number of contact updates running at the same time
+	bulkConcurrency = 5
+
+	// bulkMaxErrors is the number of errors kept in the BulkResult
+	bulkMaxErrors = 10
+
+	// bulkMaxLineSize is the largest NDJSON line (record) that can be read
+	bulkMaxLineSize = 1024 * 1024
+)
+
+// BulkContactUpdate is a single NDJSON record for BulkUpdateContacts
+//
+// ie: {"contactId":123,"attributes":{"name":"John Doe","custom_attribute":true}}
+type BulkContactUpdate struct {
+	Attributes map[string]interface{} `json:"attributes"`
+	ContactID  uint64                 `json:"contactId"`
+}
+
+// BulkResult is the result of a bulk operation
+type BulkResult struct {
+	Errors    []error `json:"-"`         // The errors of the first lines (up to 10) with their line number, in line order
+	Failed    int     `json:"failed"`    // Number of records that failed
+	Succeeded int     `json:"succeeded"` // Number of records that succeeded
+
+	lines []int // The line number of each error (records finish out of order)
+}
+
+// add will record the result of a single record
+func (r *BulkResult) add(line int, err error) {
+	if err == nil {
+		r.Succeeded++
+		return
+	}
+	r.Failed++
+
+	// Keep the lowest line numbers, whatever order the records finished in
+	index := sort.SearchInts(r.lines, line)
+	if index >= bulkMaxErrors {
+		return
+	}
+	r.lines = append(r.lines[:index], append([]int{line}, r.lines[index:]...)...)
+	r.Errors = append(r.Errors[:index], append([]error{fmt.Errorf("line %d: %w", line, err)}, r.Errors[index:]...)...)
+	if len(r.lines) > bulkMaxErrors {
+		r.lines = r.lines[:bulkMaxErrors]
+		r.Errors = r.Errors[:bulkMaxErrors]
+	}
+}
+
+// BulkUpdateContacts will read NDJSON contact updates (see: BulkContactUpdate) line by line
+// and update each contact, a few at a time. The reader is streamed (never fully loaded).
+//
+// A failed record does not stop the import; the error is returned only if reading fails
+// or the context is canceled (the result has everything processed until then)
+//
+// The attributes are sent as-is: custom attributes are not checked even if
+// ClientOptions.ValidateCustomAttributes is enabled
+//
+// ClientOptions.BatchBudget caps the whole import (context.DeadlineExceeded is returned with the
+// partial result) and ClientOptions.BatchItemBudget caps each update (a failed record)
+// specs: https://devdocs.drift.com/docs/updating-a-contact
+func (c *Client) BulkUpdateContacts(ctx context.Context, r io.Reader) (*BulkResult, error) {
+
+	// Limit the whole batch (if set)
+	if c.Options.BatchBudget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Options.BatchBudget)
+		defer cancel()
+	}
+
+	var (
+		lock    sync.Mutex
+		result  = new(BulkResult)
+		slots   = make(chan struct{}, bulkConcurrency)
+		waiting sync.WaitGroup
+	)
+
+	// record will save the result of a line
+	record := func(line int, err error) {
+		lock.Lock()
+		result.add(line, err)
+		lock.Unlock()
+	}
+
+	// Read each line (record)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), bulkMaxLineSize)
+	var err error
+	for line := 1; scanner.Scan(); line++ {
+
+		// Stop if canceled
+		if err = ctx.Err(); err != nil {
+			break
+		}
+
+		// Skip empty lines
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		// Parse the record
+		update := new(BulkContactUpdate)
+		if parseErr := Unmarshaler(data, update); parseErr != nil {
+			record(line, parseErr)
+			continue
+		}
+		if len(update.Attributes) == 0 {
+			record(line, ErrMissingContactAttributes)
+			continue
+		}
+
+		// Wait for a slot (or cancel)
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		if err != nil {
+			break
+		}
+
+		// Update the contact
+		waiting.Add(1)
+		go func(line int, update *BulkContactUpdate) {
+			defer func() {
+				<-slots
+				waiting.Done()
+			}()
+			// Limit each update (if set)
+			itemCtx := ctx
+			if c.Options.BatchItemBudget > 0 {
+				var cancel context.CancelFunc
+				itemCtx, cancel = context.WithTimeout(ctx, c.Options.BatchItemBudget)
+				defer cancel()
+			}
+			_, updateErr := c.UpdateContactRaw(itemCtx, update.ContactID, map[string]interface{}{
+				"attributes": update.Attributes,
+			})
+			record(line, updateErr)
+		}(line, update)
+	}
+
+	// Wait for the running updates
+	waiting.Wait()
+
+	// The budget ran out (or the context was canceled) after the last record was started
+	if err == nil {
+		err = ctx.Err()
+	}
+
+	// Reading failed
+	if err == nil {
+		err = scanner.Err()
+	}
+	return result, err
+}
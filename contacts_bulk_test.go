@@ -0,0 +1,170 @@
+package drift
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockHTTPBlockedUpdate for mocking contact updates that only return once the request context is done
+// (after the first allowed updates, which return right away)
+type mockHTTPBlockedUpdate struct {
+	allowed int32
+	calls   int32
+}
+
+// Do is a mock http request
+func (m *mockHTTPBlockedUpdate) Do(req *http.Request) (*http.Response, error) {
+	if atomic.AddInt32(&m.calls, 1) <= m.allowed {
+		return (&mockHTTPUpdateContact{}).Do(req)
+	}
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+// TestClient_BulkUpdateContacts tests the method BulkUpdateContacts()
+func TestClient_BulkUpdateContacts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("update contacts", func(t *testing.T) {
+		client := newTestClient(&mockHTTPUpdateContact{})
+
+		ndjson := strings.Repeat(`{"contactId":`+testContactID+`,"attributes":{"name":"`+testContactName+`"}}`+"\n", 25)
+		result, err := client.BulkUpdateContacts(context.Background(), strings.NewReader(ndjson))
+		assert.NoError(t, err)
+		assert.Equal(t, 25, result.Succeeded)
+		assert.Equal(t, 0, result.Failed)
+		assert.Equal(t, 0, len(result.Errors))
+	})
+
+	t.Run("failed records do not stop the import", func(t *testing.T) {
+		client := newTestClient(&mockHTTPUpdateContact{})
+
+		ndjson := `{"contactId":` + testContactID + `,"attributes":{"name":"` + testContactName + `"}}
+{"contactId":` + testContactID + `,"attributes":
+
+{"contactId":` + testContactID + `}
+{"contactId":0,"attributes":{"name":"` + testContactName + `"}}
+{"contactId":1,"attributes":{"name":"` + testContactName + `"}}
+{"contactId":` + testContactID + `,"attributes":{"employees":25}}`
+		result, err := client.BulkUpdateContacts(context.Background(), strings.NewReader(ndjson))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Succeeded)
+		assert.Equal(t, 4, result.Failed)
+		assert.Equal(t, 4, len(result.Errors))
+
+		var missingAttributes, missingID, badRequest bool
+		for _, resultErr := range result.Errors {
+			if strings.HasPrefix(resultErr.Error(), "line 4:") {
+				missingAttributes = assert.ErrorIs(t, resultErr, ErrMissingContactAttributes)
+			} else if strings.HasPrefix(resultErr.Error(), "line 5:") {
+				missingID = assert.ErrorIs(t, resultErr, ErrMissingContactID)
+			} else if strings.HasPrefix(resultErr.Error(), "line 6:") {
+				badRequest = assert.ErrorIs(t, resultErr, ErrBadRequest)
+			}
+		}
+		assert.True(t, missingAttributes)
+		assert.True(t, missingID)
+		assert.True(t, badRequest)
+	})
+
+	t.Run("only the first errors are kept", func(t *testing.T) {
+		client := newTestClient(&mockHTTPUpdateContact{})
+
+		ndjson := strings.Repeat(`{"contactId":1,"attributes":{"name":"`+testContactName+`"}}`+"\n", 25)
+		result, err := client.BulkUpdateContacts(context.Background(), strings.NewReader(ndjson))
+		assert.NoError(t, err)
+		assert.Equal(t, 25, result.Failed)
+		assert.Equal(t, bulkMaxErrors, len(result.Errors))
+		for i, err := range result.Errors {
+			assert.True(t, strings.HasPrefix(err.Error(), fmt.Sprintf("line %d: ", i+1)), err.Error())
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		client := newTestClient(&mockHTTPUpdateContact{})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		ndjson := `{"contactId":` + testContactID + `,"attributes":{"name":"` + testContactName + `"}}`
+		result, err := client.BulkUpdateContacts(ctx, strings.NewReader(ndjson))
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.NotNil(t, result)
+		assert.Equal(t, 0, result.Succeeded)
+	})
+
+	t.Run("line too long", func(t *testing.T) {
+		client := newTestClient(&mockHTTPUpdateContact{})
+
+		result, err := client.BulkUpdateContacts(context.Background(), strings.NewReader(strings.Repeat("a", bulkMaxLineSize+1)))
+		assert.ErrorIs(t, err, bufio.ErrTooLong)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("batch budget returns the partial result", func(t *testing.T) {
+		client := newTestClient(&mockHTTPBlockedUpdate{allowed: 3})
+		client.Options.BatchBudget = 50 * time.Millisecond
+
+		ndjson := strings.Repeat(`{"contactId":`+testContactID+`,"attributes":{"name":"`+testContactName+`"}}`+"\n", 100)
+		result, err := client.BulkUpdateContacts(context.Background(), strings.NewReader(ndjson))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.NotNil(t, result)
+		assert.Equal(t, 3, result.Succeeded)
+		assert.Less(t, result.Succeeded+result.Failed, 100)
+	})
+
+	t.Run("batch budget runs out after the last record", func(t *testing.T) {
+		client := newTestClient(&mockHTTPBlockedUpdate{})
+		client.Options.BatchBudget = 20 * time.Millisecond
+
+		ndjson := strings.Repeat(`{"contactId":`+testContactID+`,"attributes":{"name":"`+testContactName+`"}}`+"\n", 3)
+		result, err := client.BulkUpdateContacts(context.Background(), strings.NewReader(ndjson))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.NotNil(t, result)
+		assert.Equal(t, 0, result.Succeeded)
+		assert.Equal(t, 3, result.Failed)
+	})
+
+	t.Run("item budget fails slow records", func(t *testing.T) {
+		client := newTestClient(&mockHTTPBlockedUpdate{allowed: 1})
+		client.Options.BatchItemBudget = 10 * time.Millisecond
+
+		ndjson := strings.Repeat(`{"contactId":`+testContactID+`,"attributes":{"name":"`+testContactName+`"}}`+"\n", 3)
+		result, err := client.BulkUpdateContacts(context.Background(), strings.NewReader(ndjson))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Succeeded)
+		assert.Equal(t, 2, result.Failed)
+		for _, resultErr := range result.Errors {
+			assert.ErrorIs(t, resultErr, context.DeadlineExceeded)
+		}
+	})
+}
+
+// TestBulkResult_add tests the method add()
+func TestBulkResult_add(t *testing.T) {
+	t.Parallel()
+
+	t.Run("the lowest lines are kept, in line order", func(t *testing.T) {
+		result := new(BulkResult)
+		for _, line := range []int{15, 3, 12, 1, 14, 2, 11, 4, 13, 5, 10, 6, 9, 7, 8} {
+			result.add(line, ErrBadRequest)
+		}
+		result.add(16, nil)
+
+		assert.Equal(t, 15, result.Failed)
+		assert.Equal(t, 1, result.Succeeded)
+		assert.Equal(t, bulkMaxErrors, len(result.Errors))
+		for i, err := range result.Errors {
+			assert.ErrorIs(t, err, ErrBadRequest)
+			assert.Equal(t, fmt.Sprintf("line %d: %s", i+1, ErrBadRequest.Error()), err.Error())
+		}
+	})
+}
@@ -2,7 +2,6 @@ package drift
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -11,6 +10,14 @@ import (
 // specs: https://devdocs.drift.com/docs/creating-a-contact
 func (c *Client) CreateContact(ctx context.Context, attributes *ContactFields) (contact *Contact, err error) {
 
+	// Validate the fields and the custom attributes (if enabled)
+	if err = attributes.Validate(); err != nil {
+		return
+	}
+	if err = c.validateCustomAttributes(ctx, attributes); err != nil {
+		return
+	}
+
 	// Create and fire the request
 	var response *RequestResponse
 	if response, err = c.CreateContactRaw(
@@ -20,7 +27,7 @@ func (c *Client) CreateContact(ctx context.Context, attributes *ContactFields) (
 	}
 
 	// Parse the request
-	err = json.Unmarshal(response.BodyContents, &contact)
+	err = decodeResponse(response.BodyContents, &contact)
 	return
 
 }
@@ -37,7 +44,7 @@ func (c *Client) createOrUpdateContact(ctx context.Context, contactID uint64,
 
 	// Marshall the attributes
 	var data []byte
-	if data, err = json.Marshal(attributes); err != nil {
+	if data, err = Marshaler(attributes); err != nil {
 		return
 	}
 
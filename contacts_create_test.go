@@ -3,6 +3,7 @@ package drift
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -45,7 +46,7 @@ func TestClient_CreateContact(t *testing.T) {
 		// Create a req
 		contact, err := client.CreateContact(
 			context.Background(),
-			&ContactFields{&StandardAttributes{
+			&ContactFields{Attributes: &StandardAttributes{
 				Email: testContactEmail,
 				Name:  testContactName,
 				Phone: testContactPhone,
@@ -59,12 +60,53 @@ func TestClient_CreateContact(t *testing.T) {
 		assert.Equal(t, 3, contact.Data.Attributes.EndUserVersion)
 		assert.Equal(t, 1614563742010, contact.Data.Attributes.StartDate)
 	})
+
+	t.Run("missing attributes", func(t *testing.T) {
+		client := newTestClient(&mockHTTPCreateContact{})
+
+		contact, err := client.CreateContact(context.Background(), nil)
+		assert.Nil(t, contact)
+		assert.ErrorIs(t, err, ErrMissingContactAttributes)
+	})
+
+	t.Run("error responses", func(t *testing.T) {
+		tests := []struct {
+			statusCode  int
+			expectedErr error
+		}{
+			{http.StatusBadRequest, ErrBadRequest},
+			{http.StatusUnauthorized, ErrUnauthorized},
+			{http.StatusConflict, ErrConflict},
+			{http.StatusInternalServerError, ErrUnexpectedStatus},
+		}
+		for _, test := range tests {
+			client := newTestClient(&mockHTTPResponse{statusCode: test.statusCode})
+
+			contact, err := client.CreateContact(
+				context.Background(),
+				&ContactFields{Attributes: &StandardAttributes{Email: testContactEmail}})
+			assert.Nil(t, contact)
+			assert.ErrorIs(t, err, test.expectedErr)
+		}
+	})
+
+	t.Run("bad json response", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{body: `{"data":`, statusCode: http.StatusOK})
+
+		_, err := client.CreateContact(
+			context.Background(),
+			&ContactFields{Attributes: &StandardAttributes{Email: testContactEmail}})
+		assert.ErrorIs(t, err, ErrDecodeResponse)
+
+		var syntaxErr *json.SyntaxError
+		assert.ErrorAs(t, err, &syntaxErr)
+	})
 }
 
 // BenchmarkClient_CreateContact benchmarks the CreateContact method
 func BenchmarkClient_CreateContact(b *testing.B) {
 	client := newTestClient(&mockHTTPCreateContact{})
-	fields := &ContactFields{&StandardAttributes{
+	fields := &ContactFields{Attributes: &StandardAttributes{
 		Email: testContactEmail,
 		Name:  testContactName,
 		Phone: testContactPhone,
@@ -0,0 +1,90 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// OperationResult is the response from an operation that only returns a status (ie: deleting a contact)
+type OperationResult struct {
+	OK     bool   `json:"ok"`
+	Result string `json:"result"`
+}
+
+// Err will return ErrOperationFailed if the operation did not succeed
+// (some endpoints respond with a 2xx status and "ok":false)
+func (r *OperationResult) Err() error {
+	if r == nil {
+		return ErrOperationFailed
+	}
+	if !r.OK {
+		return fmt.Errorf("%w: %s", ErrOperationFailed, r.Result)
+	}
+	return nil
+}
+
+// DeleteContact will fire the HTTP request to delete an existing contact
+// specs: https://devdocs.drift.com/docs/removing-a-contact
+func (c *Client) DeleteContact(ctx context.Context, contactID uint64) (result *OperationResult, err error) {
+
+	// Create and fire the request
+	var response *RequestResponse
+	if response, err = c.DeleteContactRaw(ctx, contactID); err != nil {
+		return
+	}
+
+	// Parse the request (the request can succeed while the operation fails)
+	if err = decodeResponse(response.BodyContents, &result); err != nil {
+		return
+	}
+	err = result.Err()
+	return
+}
+
+// DeleteContactRaw will fire the HTTP request to delete an existing contact (raw response)
+// specs: https://devdocs.drift.com/docs/removing-a-contact
+func (c *Client) DeleteContactRaw(ctx context.Context, contactID uint64) (response *RequestResponse, err error) {
+
+	// Make sure we have an id
+	if contactID == 0 {
+		return nil, ErrMissingContactID
+	}
+
+	// Create and fire the request
+	if response = httpRequest(
+		ctx, c, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodDelete,
+			URL:            fmt.Sprintf(apiEndpoint+"/contacts/%d", contactID),
+		},
+	); response.Error != nil {
+		err = response.Error
+	}
+	return
+}
+
+// DeleteContactByExternalID will find the contact by external id and then delete it
+// (returns ErrResourceNotFound if no contact has the exact external id, nothing is deleted)
+// specs: https://devdocs.drift.com/docs/removing-a-contact
+func (c *Client) DeleteContactByExternalID(ctx context.Context, externalID string) (*OperationResult, error) {
+
+	// Make sure we have an external id
+	if len(externalID) == 0 {
+		return nil, ErrMissingExternalID
+	}
+
+	// Find the contact
+	contacts, err := c.GetContacts(ctx, &ContactQuery{ExternalID: externalID, Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	// Make sure it is the right contact before deleting it
+	if len(contacts.Data) == 0 || contacts.Data[0] == nil || contacts.Data[0].ID == 0 ||
+		contacts.Data[0].Attributes == nil || contacts.Data[0].Attributes.ExternalID != externalID {
+		return nil, fmt.Errorf("%w: contact with external id %s", ErrResourceNotFound, externalID)
+	}
+
+	// Delete the contact
+	return c.DeleteContact(ctx, contacts.Data[0].ID)
+}
@@ -0,0 +1,177 @@
+package drift
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockHTTPDeleteContact for mocking requests
+type mockHTTPDeleteContact struct {
+	deletes int32
+}
+
+// Do is a mock http request
+func (m *mockHTTPDeleteContact) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, fmt.Errorf("missing request")
+	}
+
+	// Count the deletes
+	if req.Method == http.MethodDelete {
+		atomic.AddInt32(&m.deletes, 1)
+	}
+
+	// Valid responses
+	if req.Method == http.MethodDelete && req.URL.String() == apiEndpoint+"/contacts/"+testContactID {
+		resp.StatusCode = http.StatusOK
+		resp.Body = ioutil.NopCloser(bytes.NewBuffer([]byte(`{"result":"OK","ok":true}`)))
+	} else if req.Method == http.MethodDelete && req.URL.String() == apiEndpoint+"/contacts/"+testContactIDBadRequest {
+		resp.StatusCode = http.StatusOK
+		resp.Body = ioutil.NopCloser(bytes.NewBuffer([]byte(`{"result":"contact is locked","ok":false}`)))
+	} else if req.Method == http.MethodGet && req.URL.String() == apiEndpoint+"/contacts?idType=external&id="+testExternalID+"&limit=1" {
+		resp.StatusCode = http.StatusOK
+		resp.Body = ioutil.NopCloser(bytes.NewBuffer([]byte(`{"data":[{"id":` + testContactID + `,"createdAt":1606273669631,"attributes":{"externalId":"` + testExternalID + `"}}]}`)))
+	} else if req.Method == http.MethodGet && req.URL.String() == apiEndpoint+"/contacts?idType=external&id=other-id&limit=1" {
+		resp.StatusCode = http.StatusOK
+		resp.Body = ioutil.NopCloser(bytes.NewBuffer([]byte(`{"data":[{"id":` + testContactID + `,"createdAt":1606273669631,"attributes":{"externalId":"` + testExternalID + `"}}]}`)))
+	} else if req.Method == http.MethodGet {
+		resp.StatusCode = http.StatusOK
+		resp.Body = ioutil.NopCloser(bytes.NewBuffer([]byte(`{"data":[]}`)))
+	}
+
+	// Default is valid
+	return resp, nil
+}
+
+// TestClient_DeleteContact tests the method DeleteContact()
+func TestClient_DeleteContact(t *testing.T) {
+	t.Parallel()
+
+	t.Run("delete a contact", func(t *testing.T) {
+		client := newTestClient(&mockHTTPDeleteContact{})
+
+		id, err := strconv.ParseUint(testContactID, 10, 64)
+		assert.NoError(t, err)
+
+		var result *OperationResult
+		result, err = client.DeleteContact(context.Background(), id)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, true, result.OK)
+		assert.Equal(t, "OK", result.Result)
+	})
+
+	t.Run("missing contact id", func(t *testing.T) {
+		client := newTestClient(&mockHTTPDeleteContact{})
+
+		result, err := client.DeleteContact(context.Background(), 0)
+		assert.ErrorIs(t, err, ErrMissingContactID)
+		assert.Nil(t, result)
+	})
+
+	t.Run("request succeeded but operation failed", func(t *testing.T) {
+		client := newTestClient(&mockHTTPDeleteContact{})
+
+		id, err := strconv.ParseUint(testContactIDBadRequest, 10, 64)
+		assert.NoError(t, err)
+
+		var result *OperationResult
+		result, err = client.DeleteContact(context.Background(), id)
+		assert.ErrorIs(t, err, ErrOperationFailed)
+		assert.Contains(t, err.Error(), "contact is locked")
+		assert.NotNil(t, result)
+		assert.Equal(t, false, result.OK)
+	})
+
+	t.Run("bad request response", func(t *testing.T) {
+		client := newTestClient(&mockHTTPDeleteContact{})
+
+		result, err := client.DeleteContact(context.Background(), 1)
+		assert.ErrorIs(t, err, ErrBadRequest)
+		assert.Nil(t, result)
+	})
+}
+
+// TestClient_DeleteContactByExternalID tests the method DeleteContactByExternalID()
+func TestClient_DeleteContactByExternalID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("delete a contact by external id", func(t *testing.T) {
+		client := newTestClient(&mockHTTPDeleteContact{})
+
+		result, err := client.DeleteContactByExternalID(context.Background(), testExternalID)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, true, result.OK)
+	})
+
+	t.Run("missing external id", func(t *testing.T) {
+		client := newTestClient(&mockHTTPDeleteContact{})
+
+		result, err := client.DeleteContactByExternalID(context.Background(), "")
+		assert.ErrorIs(t, err, ErrMissingExternalID)
+		assert.Nil(t, result)
+	})
+
+	t.Run("contact not found", func(t *testing.T) {
+		mock := &mockHTTPDeleteContact{}
+		client := newTestClient(mock)
+
+		result, err := client.DeleteContactByExternalID(context.Background(), "unknown")
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+		assert.Nil(t, result)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&mock.deletes))
+	})
+
+	t.Run("a different contact is not deleted", func(t *testing.T) {
+		mock := &mockHTTPDeleteContact{}
+		client := newTestClient(mock)
+
+		result, err := client.DeleteContactByExternalID(context.Background(), "other-id")
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+		assert.Nil(t, result)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&mock.deletes))
+	})
+
+	t.Run("external id is escaped", func(t *testing.T) {
+		mock := &mockHTTPDeleteContact{}
+		client := newTestClient(mock)
+
+		result, err := client.DeleteContactByExternalID(context.Background(), testExternalID+"&email=victim@x.com")
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+		assert.Nil(t, result)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&mock.deletes))
+	})
+}
+
+// TestOperationResult_Err tests the method Err()
+func TestOperationResult_Err(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, (&OperationResult{OK: true, Result: "OK"}).Err())
+	assert.ErrorIs(t, (&OperationResult{Result: "failed"}).Err(), ErrOperationFailed)
+
+	var result *OperationResult
+	assert.ErrorIs(t, result.Err(), ErrOperationFailed)
+}
+
+// BenchmarkClient_DeleteContact benchmarks the DeleteContact method
+func BenchmarkClient_DeleteContact(b *testing.B) {
+	client := newTestClient(&mockHTTPDeleteContact{})
+	id, _ := strconv.ParseUint(testContactID, 10, 64)
+	for i := 0; i < b.N; i++ {
+		_, _ = client.DeleteContact(context.Background(), id)
+	}
+}
@@ -2,12 +2,16 @@ package drift
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 // ContactQuery is how we want to get a contact(s)
+//
+// Set exactly one identifier: ID (single contact), Email or ExternalID (multiple contacts)
 type ContactQuery struct {
 	Email      string `json:"email"`
 	ExternalID string `json:"external_id"`
@@ -15,27 +19,54 @@ type ContactQuery struct {
 	Limit      int    `json:"limit"`
 }
 
+// Validate will check the query before any request is made (called by BuildURL)
+func (q *ContactQuery) Validate() error {
+
+	// Make sure we have something to search for
+	if q == nil || (len(q.ID) == 0 && len(q.Email) == 0 && len(q.ExternalID) == 0) {
+		return ErrMissingContactIdentifier
+	}
+
+	// Only one identifier can be used (there is no precedence between them)
+	identifiers := 0
+	for _, identifier := range []string{q.ID, q.Email, q.ExternalID} {
+		if len(identifier) > 0 {
+			identifiers++
+		}
+	}
+	if identifiers > 1 {
+		return ErrAmbiguousContactQuery
+	}
+
+	// Make sure the limit is valid (zero is the default)
+	if q.Limit < 0 {
+		return ErrInvalidLimit
+	}
+	return nil
+}
+
 // BuildURL will build a url depending on our query params
 func (q *ContactQuery) BuildURL() (queryURL string, err error) {
 
-	// Make sure we have something to search for
-	if len(q.ID) == 0 && len(q.Email) == 0 && len(q.ExternalID) == 0 {
-		err = fmt.Errorf("contact id, email or external id is required")
+	// Make sure the query is valid
+	if err = q.Validate(); err != nil {
 		return
 	}
 
-	// Set a default limit if no limit is given
+	// Set a default limit if no limit is given (see: ClientOptions.DefaultListLimit)
 	if q.Limit == 0 {
 		q.Limit = 1
 	}
 
-	// Got an ID (highest priority)
+	// Build by the identifier (only one is set, query values are escaped)
 	if len(q.ID) > 0 {
 		queryURL = apiEndpoint + "/contacts/" + q.ID
-	} else if len(q.Email) > 0 { // Next is email
-		queryURL = fmt.Sprintf("%s/contacts?email=%s&limit=%d", apiEndpoint, q.Email, q.Limit)
-	} else if len(q.ExternalID) > 0 { // Next is external id
-		queryURL = fmt.Sprintf("%s/contacts?idType=external&id=%s&limit=%d", apiEndpoint, q.ExternalID, q.Limit)
+	} else if len(q.Email) > 0 {
+		queryURL = fmt.Sprintf("%s/contacts?email=%s&limit=%d", apiEndpoint, url.QueryEscape(q.Email), q.Limit)
+	} else if len(q.ExternalID) > 0 {
+		queryURL = fmt.Sprintf(
+			"%s/contacts?idType=external&id=%s&limit=%d", apiEndpoint, url.QueryEscape(q.ExternalID), q.Limit,
+		)
 	}
 	return
 }
@@ -45,6 +76,34 @@ func (q *ContactQuery) HasMultipleResults() bool {
 	return len(q.ID) == 0
 }
 
+// GetContact will get a single contact by id (returns ErrResourceNotFound if not found)
+// specs: https://devdocs.drift.com/docs/retrieving-contact
+func (c *Client) GetContact(ctx context.Context, contactID uint64) (contact *Contact, err error) {
+
+	// Make sure we have an id
+	if contactID == 0 {
+		return nil, ErrMissingContactID
+	}
+
+	// Create and fire the request
+	var response *RequestResponse
+	if response, err = c.GetContactsRaw(
+		ctx, &ContactQuery{ID: strconv.FormatUint(contactID, 10)},
+	); err != nil {
+		return
+	}
+
+	// Parse the request (no data means there is no contact)
+	data := new(contactData)
+	if err = response.DecodeData(data); err != nil {
+		if errors.Is(err, ErrEmptyResponse) {
+			err = fmt.Errorf("%w: %s", ErrResourceNotFound, response.URL)
+		}
+		return nil, err
+	}
+	return &Contact{Data: data}, nil
+}
+
 // GetContacts will get the contact data, but then parse into a standard contact (no custom attributes)
 // specs: https://devdocs.drift.com/docs/retrieving-contact
 func (c *Client) GetContacts(ctx context.Context, query *ContactQuery) (contacts *Contacts, err error) {
@@ -60,7 +119,7 @@ func (c *Client) GetContacts(ctx context.Context, query *ContactQuery) (contacts
 	// Determine if single or multiple
 	contacts = new(Contacts)
 	if query.HasMultipleResults() {
-		if err = json.Unmarshal(
+		if err = decodeResponse(
 			response.BodyContents, &contacts,
 		); err != nil {
 			contacts = nil
@@ -68,7 +127,7 @@ func (c *Client) GetContacts(ctx context.Context, query *ContactQuery) (contacts
 		}
 	} else { // Parse as a single contact
 		contact := new(Contact)
-		if err = json.Unmarshal(
+		if err = decodeResponse(
 			response.BodyContents, &contact,
 		); err != nil {
 			contacts = nil
@@ -83,8 +142,21 @@ func (c *Client) GetContacts(ctx context.Context, query *ContactQuery) (contacts
 // GetContactsRaw will fire the HTTP request to retrieve the raw contact data
 // specs: https://devdocs.drift.com/docs/retrieving-contact
 func (c *Client) GetContactsRaw(ctx context.Context, query *ContactQuery) (response *RequestResponse, err error) {
+
+	// Make sure the query is valid (before copying it)
+	if err = query.Validate(); err != nil {
+		return
+	}
+
+	// Use the client default limit (if set) when no limit is given
+	// (on a copy, the query is never changed so it can be reused or shared)
+	effective := *query
+	if effective.Limit == 0 && c.Options.DefaultListLimit > 0 {
+		effective.Limit = c.Options.DefaultListLimit
+	}
+
 	var queryURL string
-	if queryURL, err = query.BuildURL(); err != nil {
+	if queryURL, err = effective.BuildURL(); err != nil {
 		return
 	}
 	if response = httpRequest(
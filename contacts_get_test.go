@@ -3,9 +3,12 @@ package drift
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -43,6 +46,70 @@ func (m *mockHTTPGetContacts) Do(req *http.Request) (*http.Response, error) {
 	return resp, nil
 }
 
+// TestClient_GetContact tests the method GetContact()
+func TestClient_GetContact(t *testing.T) {
+	t.Parallel()
+
+	t.Run("get a valid contact by id", func(t *testing.T) {
+		client := newTestClient(&mockHTTPGetContacts{})
+
+		id, err := strconv.ParseUint(testContactID, 10, 64)
+		assert.NoError(t, err)
+
+		var contact *Contact
+		contact, err = client.GetContact(context.Background(), id)
+		assert.NoError(t, err)
+		assert.NotNil(t, contact)
+		assert.Equal(t, id, contact.Data.ID)
+		assert.Equal(t, testContactName, contact.Data.Attributes.Name)
+		assert.Equal(t, testContactEmail, contact.Data.Attributes.Email)
+	})
+
+	t.Run("missing contact id", func(t *testing.T) {
+		client := newTestClient(&mockHTTPGetContacts{})
+
+		contact, err := client.GetContact(context.Background(), 0)
+		assert.ErrorIs(t, err, ErrMissingContactID)
+		assert.Nil(t, contact)
+	})
+
+	t.Run("not found response", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{statusCode: http.StatusNotFound})
+
+		contact, err := client.GetContact(context.Background(), 1)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+		assert.Nil(t, contact)
+	})
+
+	t.Run("empty data", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{body: `{"data":null}`, statusCode: http.StatusOK})
+
+		contact, err := client.GetContact(context.Background(), 1)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+		assert.Nil(t, contact)
+	})
+
+	t.Run("missing data", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{body: `{}`, statusCode: http.StatusOK})
+
+		contact, err := client.GetContact(context.Background(), 1)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+		assert.Nil(t, contact)
+	})
+
+	t.Run("bad json response", func(t *testing.T) {
+		client := newTestClient(&mockHTTPGetContacts{})
+
+		id, err := strconv.ParseUint(testContactIDBadJSON, 10, 64)
+		assert.NoError(t, err)
+
+		var contact *Contact
+		contact, err = client.GetContact(context.Background(), id)
+		assert.Error(t, err)
+		assert.Nil(t, contact)
+	})
+}
+
 // TestClient_GetContacts tests the method GetContacts()
 func TestClient_GetContacts(t *testing.T) {
 	t.Parallel()
@@ -84,7 +151,7 @@ func TestClient_GetContacts(t *testing.T) {
 		contact, err := client.GetContacts(context.Background(), &ContactQuery{
 			ID: testContactIDBadRequest,
 		})
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrBadRequest)
 		assert.Nil(t, contact)
 	})
 
@@ -96,7 +163,7 @@ func TestClient_GetContacts(t *testing.T) {
 		contact, err := client.GetContacts(context.Background(), &ContactQuery{
 			ID: testContactIDUnauthorized,
 		})
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrUnauthorized)
 		assert.Nil(t, contact)
 	})
 
@@ -109,6 +176,8 @@ func TestClient_GetContacts(t *testing.T) {
 			ID: testContactIDBadJSON,
 		})
 		assert.Error(t, err)
+		var syntaxErr *json.SyntaxError
+		assert.ErrorAs(t, err, &syntaxErr)
 		assert.Nil(t, contact)
 	})
 }
@@ -127,6 +196,48 @@ func TestClient_GetContactsRaw(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("nil query", func(t *testing.T) {
+		client := newTestClient(&mockHTTPGetContacts{})
+
+		response, err := client.GetContactsRaw(context.Background(), nil)
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, ErrMissingContactIdentifier)
+	})
+
+	t.Run("client default limit", func(t *testing.T) {
+		client := newTestClient(&mockHTTPGetContacts{})
+		client.Options.DefaultListLimit = 50
+
+		q := &ContactQuery{Email: testContactEmail}
+		response, _ := client.GetContactsRaw(context.Background(), q)
+		assert.NotNil(t, response)
+		assert.Equal(t, apiEndpoint+"/contacts?email="+url.QueryEscape(testContactEmail)+"&limit=50", response.URL)
+	})
+
+	t.Run("query is not changed", func(t *testing.T) {
+		client := newTestClient(&mockHTTPGetContacts{})
+		client.Options.DefaultListLimit = 50
+
+		q := &ContactQuery{Email: testContactEmail}
+		_, _ = client.GetContactsRaw(context.Background(), q)
+		assert.Equal(t, 0, q.Limit)
+
+		// Reused with a client without a default (package default)
+		response, _ := newTestClient(&mockHTTPGetContacts{}).GetContactsRaw(context.Background(), q)
+		assert.NotNil(t, response)
+		assert.Equal(t, apiEndpoint+"/contacts?email="+url.QueryEscape(testContactEmail)+"&limit=1", response.URL)
+	})
+
+	t.Run("explicit limit over client default", func(t *testing.T) {
+		client := newTestClient(&mockHTTPGetContacts{})
+		client.Options.DefaultListLimit = 50
+
+		q := &ContactQuery{Email: testContactEmail, Limit: 5}
+		response, _ := client.GetContactsRaw(context.Background(), q)
+		assert.NotNil(t, response)
+		assert.Equal(t, apiEndpoint+"/contacts?email="+url.QueryEscape(testContactEmail)+"&limit=5", response.URL)
+	})
+
 	t.Run("get a valid contact by id", func(t *testing.T) {
 		// Create a client
 		client := newTestClient(&mockHTTPGetContacts{})
@@ -180,7 +291,26 @@ func TestContactQuery_BuildURL(t *testing.T) {
 	t.Run("requires an identifier to search", func(t *testing.T) {
 		q := &ContactQuery{}
 		queryURL, err := q.BuildURL()
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrMissingContactIdentifier)
+		assert.Equal(t, "", queryURL)
+	})
+
+	t.Run("more than one identifier", func(t *testing.T) {
+		q := &ContactQuery{ID: testContactID, Email: testContactEmail}
+		queryURL, err := q.BuildURL()
+		assert.ErrorIs(t, err, ErrAmbiguousContactQuery)
+		assert.Equal(t, "", queryURL)
+
+		q = &ContactQuery{Email: testContactEmail, ExternalID: "123"}
+		queryURL, err = q.BuildURL()
+		assert.ErrorIs(t, err, ErrAmbiguousContactQuery)
+		assert.Equal(t, "", queryURL)
+	})
+
+	t.Run("negative limit", func(t *testing.T) {
+		q := &ContactQuery{Email: testContactEmail, Limit: -1}
+		queryURL, err := q.BuildURL()
+		assert.ErrorIs(t, err, ErrInvalidLimit)
 		assert.Equal(t, "", queryURL)
 	})
 
@@ -203,21 +333,33 @@ func TestContactQuery_BuildURL(t *testing.T) {
 		q := &ContactQuery{Email: testContactEmail}
 		queryURL, err := q.BuildURL()
 		assert.NoError(t, err)
-		assert.Equal(t, fmt.Sprintf(apiEndpoint+"/contacts?email="+testContactEmail+"&limit=%d", q.Limit), queryURL)
+		assert.Equal(t, fmt.Sprintf("%s/contacts?email=%s&limit=%d", apiEndpoint, url.QueryEscape(testContactEmail), q.Limit), queryURL)
 	})
 
 	t.Run("url by contact external id", func(t *testing.T) {
 		q := &ContactQuery{ExternalID: testContactEmail}
 		queryURL, err := q.BuildURL()
 		assert.NoError(t, err)
-		assert.Equal(t, fmt.Sprintf(apiEndpoint+"/contacts?idType=external&id="+testContactEmail+"&limit=%d", q.Limit), queryURL)
+		assert.Equal(t, fmt.Sprintf("%s/contacts?idType=external&id=%s&limit=%d", apiEndpoint, url.QueryEscape(testContactEmail), q.Limit), queryURL)
 	})
 
 	t.Run("custom limit", func(t *testing.T) {
 		q := &ContactQuery{Email: testContactEmail, Limit: 123}
 		queryURL, err := q.BuildURL()
 		assert.NoError(t, err)
-		assert.Equal(t, fmt.Sprintf(apiEndpoint+"/contacts?email="+testContactEmail+"&limit=%d", 123), queryURL)
+		assert.Equal(t, fmt.Sprintf("%s/contacts?email=%s&limit=%d", apiEndpoint, url.QueryEscape(testContactEmail), 123), queryURL)
+	})
+
+	t.Run("query values are escaped", func(t *testing.T) {
+		q := &ContactQuery{ExternalID: "abc&email=victim@x.com"}
+		queryURL, err := q.BuildURL()
+		assert.NoError(t, err)
+		assert.Equal(t, apiEndpoint+"/contacts?idType=external&id=abc%26email%3Dvictim%40x.com&limit=1", queryURL)
+
+		q = &ContactQuery{Email: "john+test@email.com"}
+		queryURL, err = q.BuildURL()
+		assert.NoError(t, err)
+		assert.Equal(t, apiEndpoint+"/contacts?email=john%2Btest%40email.com&limit=1", queryURL)
 	})
 }
 
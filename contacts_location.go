@@ -0,0 +1,29 @@
+package drift
+
+import "fmt"
+
+// ContactLocation is the last known location of a contact (from the last_context_location attribute)
+type ContactLocation struct {
+	City        string  `json:"city"`
+	Country     string  `json:"country"`
+	CountryName string  `json:"countryName"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	PostalCode  string  `json:"postalCode"`
+	Region      string  `json:"region"`
+}
+
+// Location will parse the contact's last context location (a JSON encoded string)
+//
+// Returns nil (and no error) if the contact has no location
+func (c *Contact) Location() (*ContactLocation, error) {
+	if c == nil || c.Data == nil || c.Data.Attributes == nil ||
+		len(c.Data.Attributes.LastContextLocation) == 0 {
+		return nil, nil
+	}
+	location := new(ContactLocation)
+	if err := Unmarshaler([]byte(c.Data.Attributes.LastContextLocation), location); err != nil {
+		return nil, fmt.Errorf("failed parsing last_context_location: %w", err)
+	}
+	return location, nil
+}
@@ -0,0 +1,59 @@
+package drift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestContact_Location tests the method Location()
+func TestContact_Location(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parse the location", func(t *testing.T) {
+		client := newTestClient(&mockHTTPGetContacts{})
+		contacts, err := client.GetContacts(context.Background(), &ContactQuery{ID: testContactID})
+		assert.NoError(t, err)
+
+		contact := &Contact{Data: contacts.Data[0]}
+		var location *ContactLocation
+		location, err = contact.Location()
+		assert.NoError(t, err)
+		assert.NotNil(t, location)
+		assert.Equal(t, "NYC", location.City)
+		assert.Equal(t, "New York", location.Region)
+		assert.Equal(t, "US", location.Country)
+		assert.Equal(t, "United States", location.CountryName)
+		assert.Equal(t, "10901", location.PostalCode)
+		assert.Equal(t, 25.5397, location.Latitude)
+		assert.Equal(t, -84.5151, location.Longitude)
+	})
+
+	t.Run("no location", func(t *testing.T) {
+		contact := &Contact{Data: &contactData{Attributes: &attributes{}}}
+		location, err := contact.Location()
+		assert.NoError(t, err)
+		assert.Nil(t, location)
+	})
+
+	t.Run("nil contact", func(t *testing.T) {
+		var contact *Contact
+		location, err := contact.Location()
+		assert.NoError(t, err)
+		assert.Nil(t, location)
+
+		location, err = (&Contact{}).Location()
+		assert.NoError(t, err)
+		assert.Nil(t, location)
+	})
+
+	t.Run("malformed location", func(t *testing.T) {
+		contact := &Contact{Data: &contactData{Attributes: &attributes{
+			LastContextLocation: `{"city":"NYC"`,
+		}}}
+		location, err := contact.Location()
+		assert.Error(t, err)
+		assert.Nil(t, location)
+	})
+}
@@ -0,0 +1,134 @@
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// ContactTag is a tag on a contact
+type ContactTag struct {
+	Color string `json:"color,omitempty"`
+	Name  string `json:"name"`
+}
+
+// UnmarshalJSON will parse a tag object, or a tag that is only a name
+func (t *ContactTag) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &t.Name)
+	}
+	type tag ContactTag // Prevents recursion
+	return json.Unmarshal(data, (*tag)(t))
+}
+
+// contactTagFields is used for updating the tags of a contact
+type contactTagFields struct {
+	Attributes struct {
+		Tags []*ContactTag `json:"tags"`
+	} `json:"attributes"`
+}
+
+// AddContactTags will add the tags to an existing contact (existing tags are kept)
+//
+// Tag names are trimmed. Not atomic: the current tags are read then written back (see: updateContactTags)
+// specs: https://devdocs.drift.com/docs/updating-a-contact
+func (c *Client) AddContactTags(ctx context.Context, contactID uint64,
+	tags []string) (*Contact, error) {
+
+	// Make sure we have tags to add
+	if len(tags) == 0 {
+		return nil, ErrMissingContactTags
+	}
+	for _, tag := range tags {
+		if len(strings.TrimSpace(tag)) == 0 {
+			return nil, ErrMissingContactTags
+		}
+	}
+
+	return c.updateContactTags(ctx, contactID, func(current []*ContactTag) []*ContactTag {
+		for _, tag := range tags {
+			tag = strings.TrimSpace(tag)
+			if !hasContactTag(current, tag) {
+				current = append(current, &ContactTag{Name: tag})
+			}
+		}
+		return current
+	})
+}
+
+// RemoveContactTag will remove the tag from an existing contact
+//
+// Not atomic: the current tags are read then written back (see: updateContactTags)
+// specs: https://devdocs.drift.com/docs/updating-a-contact
+func (c *Client) RemoveContactTag(ctx context.Context, contactID uint64,
+	tag string) (*Contact, error) {
+
+	// Make sure we have a tag to remove
+	if tag = strings.TrimSpace(tag); len(tag) == 0 {
+		return nil, ErrMissingContactTags
+	}
+
+	return c.updateContactTags(ctx, contactID, func(current []*ContactTag) []*ContactTag {
+		tags := make([]*ContactTag, 0, len(current))
+		for _, existing := range current {
+			if !strings.EqualFold(existing.Name, tag) {
+				tags = append(tags, existing)
+			}
+		}
+		return tags
+	})
+}
+
+// updateContactTags will get the current tags of a contact, modify them and update the contact
+//
+// This is a read-modify-write and is not atomic: concurrent changes to the same contact's tags
+// can overwrite each other (the last update wins), so serialize tag changes per contact
+func (c *Client) updateContactTags(ctx context.Context, contactID uint64,
+	modify func(current []*ContactTag) []*ContactTag) (contact *Contact, err error) {
+
+	// Make sure we have an id (otherwise a new contact would be created)
+	if contactID == 0 {
+		return nil, ErrMissingContactID
+	}
+
+	// Get the current tags
+	var contacts *Contacts
+	if contacts, err = c.GetContacts(ctx, &ContactQuery{
+		ID: strconv.FormatUint(contactID, 10),
+	}); err != nil {
+		return
+	}
+	var current []*ContactTag
+	if len(contacts.Data) > 0 && contacts.Data[0] != nil && contacts.Data[0].Attributes != nil {
+		for _, tag := range contacts.Data[0].Attributes.Tags {
+			if tag != nil {
+				current = append(current, tag)
+			}
+		}
+	}
+
+	// Update the contact with the new tags
+	fields := new(contactTagFields)
+	if fields.Attributes.Tags = modify(current); fields.Attributes.Tags == nil {
+		fields.Attributes.Tags = []*ContactTag{}
+	}
+	var response *RequestResponse
+	if response, err = c.UpdateContactRaw(ctx, contactID, fields); err != nil {
+		return
+	}
+
+	// Parse the request
+	err = decodeResponse(response.BodyContents, &contact)
+	return
+}
+
+// hasContactTag will return true if the tag name is found (case-insensitive)
+func hasContactTag(tags []*ContactTag, name string) bool {
+	for _, tag := range tags {
+		if strings.EqualFold(tag.Name, name) {
+			return true
+		}
+	}
+	return false
+}
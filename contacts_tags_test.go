@@ -0,0 +1,146 @@
+package drift
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockHTTPContactTags for mocking requests
+type mockHTTPContactTags struct{}
+
+// Do is a mock http request
+func (m *mockHTTPContactTags) Do(req *http.Request) (*http.Response, error) {
+	resp := new(http.Response)
+	resp.StatusCode = http.StatusBadRequest
+
+	// No req found
+	if req == nil {
+		return resp, fmt.Errorf("missing request")
+	}
+
+	// Not the test contact
+	if req.URL.String() != apiEndpoint+"/contacts/"+testContactID {
+		return resp, nil
+	}
+
+	// Current contact
+	resp.StatusCode = http.StatusOK
+	if req.Method == http.MethodGet {
+		resp.Body = ioutil.NopCloser(bytes.NewBuffer([]byte(`{"data":{"id":` + testContactID + `,"createdAt":1606273669631,"attributes":{"name":"` + testContactName + `","tags":[{"name":"existing","color":"#000000"}]}}}`)))
+		return resp, nil
+	}
+
+	// Updated contact (echo the tags)
+	body, _ := ioutil.ReadAll(req.Body)
+	fields := new(contactTagFields)
+	_ = json.Unmarshal(body, &fields)
+	tags, _ := json.Marshal(fields.Attributes.Tags)
+	resp.Body = ioutil.NopCloser(bytes.NewBuffer([]byte(`{"data":{"id":` + testContactID + `,"createdAt":1606273669631,"attributes":{"name":"` + testContactName + `","tags":` + string(tags) + `}}}`)))
+	return resp, nil
+}
+
+// TestClient_AddContactTags tests the method AddContactTags()
+func TestClient_AddContactTags(t *testing.T) {
+	t.Parallel()
+
+	id, err := strconv.ParseUint(testContactID, 10, 64)
+	assert.NoError(t, err)
+
+	t.Run("add new tags", func(t *testing.T) {
+		client := newTestClient(&mockHTTPContactTags{})
+
+		contact, err := client.AddContactTags(context.Background(), id, []string{"new", "Existing"})
+		assert.NoError(t, err)
+		assert.NotNil(t, contact)
+		assert.Equal(t, 2, len(contact.Data.Attributes.Tags))
+		assert.Equal(t, "existing", contact.Data.Attributes.Tags[0].Name)
+		assert.Equal(t, "#000000", contact.Data.Attributes.Tags[0].Color)
+		assert.Equal(t, "new", contact.Data.Attributes.Tags[1].Name)
+	})
+
+	t.Run("tag names are trimmed", func(t *testing.T) {
+		client := newTestClient(&mockHTTPContactTags{})
+
+		contact, err := client.AddContactTags(context.Background(), id, []string{" vip ", "vip", " existing"})
+		assert.NoError(t, err)
+		assert.NotNil(t, contact)
+		assert.Equal(t, 2, len(contact.Data.Attributes.Tags))
+		assert.Equal(t, "existing", contact.Data.Attributes.Tags[0].Name)
+		assert.Equal(t, "vip", contact.Data.Attributes.Tags[1].Name)
+	})
+
+	t.Run("missing contact id", func(t *testing.T) {
+		client := newTestClient(&mockHTTPContactTags{})
+
+		contact, err := client.AddContactTags(context.Background(), 0, []string{"new"})
+		assert.ErrorIs(t, err, ErrMissingContactID)
+		assert.Nil(t, contact)
+	})
+
+	t.Run("missing tags", func(t *testing.T) {
+		client := newTestClient(&mockHTTPContactTags{})
+
+		contact, err := client.AddContactTags(context.Background(), id, nil)
+		assert.ErrorIs(t, err, ErrMissingContactTags)
+		assert.Nil(t, contact)
+
+		contact, err = client.AddContactTags(context.Background(), id, []string{" "})
+		assert.ErrorIs(t, err, ErrMissingContactTags)
+		assert.Nil(t, contact)
+	})
+
+	t.Run("contact not found", func(t *testing.T) {
+		client := newTestClient(&mockHTTPContactTags{})
+
+		contact, err := client.AddContactTags(context.Background(), 1, []string{"new"})
+		assert.ErrorIs(t, err, ErrBadRequest)
+		assert.Nil(t, contact)
+	})
+}
+
+// TestClient_RemoveContactTag tests the method RemoveContactTag()
+func TestClient_RemoveContactTag(t *testing.T) {
+	t.Parallel()
+
+	id, err := strconv.ParseUint(testContactID, 10, 64)
+	assert.NoError(t, err)
+
+	t.Run("remove a tag", func(t *testing.T) {
+		client := newTestClient(&mockHTTPContactTags{})
+
+		contact, err := client.RemoveContactTag(context.Background(), id, " EXISTING ")
+		assert.NoError(t, err)
+		assert.NotNil(t, contact)
+		assert.NotNil(t, contact.Data.Attributes.Tags)
+		assert.Equal(t, 0, len(contact.Data.Attributes.Tags))
+	})
+
+	t.Run("missing tag", func(t *testing.T) {
+		client := newTestClient(&mockHTTPContactTags{})
+
+		contact, err := client.RemoveContactTag(context.Background(), id, "")
+		assert.ErrorIs(t, err, ErrMissingContactTags)
+		assert.Nil(t, contact)
+	})
+}
+
+// TestContactTag_UnmarshalJSON tests the method UnmarshalJSON()
+func TestContactTag_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var tags []*ContactTag
+	err := json.Unmarshal([]byte(`[{"name":"first","color":"#ffffff"},"second"]`), &tags)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(tags))
+	assert.Equal(t, "first", tags[0].Name)
+	assert.Equal(t, "#ffffff", tags[0].Color)
+	assert.Equal(t, "second", tags[1].Name)
+}
@@ -1,15 +1,20 @@
 package drift
 
-import (
-	"context"
-	"encoding/json"
-)
+import "context"
 
 // UpdateContact will fire the HTTP request to update an existing contact
 // specs: https://devdocs.drift.com/docs/creating-a-contact
 func (c *Client) UpdateContact(ctx context.Context, contactID uint64,
 	attributes *ContactFields) (contact *Contact, err error) {
 
+	// Validate the fields and the custom attributes (if enabled)
+	if err = attributes.Validate(); err != nil {
+		return
+	}
+	if err = c.validateCustomAttributes(ctx, attributes); err != nil {
+		return
+	}
+
 	// Create and fire the request
 	var response *RequestResponse
 	if response, err = c.UpdateContactRaw(
@@ -19,7 +24,7 @@ func (c *Client) UpdateContact(ctx context.Context, contactID uint64,
 	}
 
 	// Parse the request
-	err = json.Unmarshal(response.BodyContents, &contact)
+	err = decodeResponse(response.BodyContents, &contact)
 	return
 
 }
@@ -28,5 +33,10 @@ func (c *Client) UpdateContact(ctx context.Context, contactID uint64,
 // specs: https://devdocs.drift.com/docs/updating-a-contact
 func (c *Client) UpdateContactRaw(ctx context.Context, contactID uint64,
 	attributes interface{}) (*RequestResponse, error) {
+
+	// Without an id this would create a new contact
+	if contactID == 0 {
+		return nil, ErrMissingContactID
+	}
 	return c.createOrUpdateContact(ctx, contactID, attributes)
 }
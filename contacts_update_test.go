@@ -50,7 +50,7 @@ func TestClient_UpdateContact(t *testing.T) {
 		var contact *Contact
 		contact, err = client.UpdateContact(
 			context.Background(), id,
-			&ContactFields{&StandardAttributes{
+			&ContactFields{Attributes: &StandardAttributes{
 				Name: testContactName + "2",
 			}})
 		assert.NotNil(t, contact)
@@ -61,13 +61,74 @@ func TestClient_UpdateContact(t *testing.T) {
 		assert.Equal(t, int64(1606273669631), contact.Data.CreatedAt)
 		assert.Equal(t, testContactName+"2", contact.Data.Attributes.Name)
 	})
+
+	t.Run("missing contact id", func(t *testing.T) {
+		client := newTestClient(&mockHTTPUpdateContact{})
+
+		contact, err := client.UpdateContact(
+			context.Background(), 0,
+			&ContactFields{Attributes: &StandardAttributes{
+				Name: testContactName,
+			}})
+		assert.Nil(t, contact)
+		assert.ErrorIs(t, err, ErrMissingContactID)
+	})
+
+	t.Run("missing attributes", func(t *testing.T) {
+		client := newTestClient(&mockHTTPUpdateContact{})
+
+		id, err := strconv.ParseUint(testContactID, 10, 64)
+		assert.NoError(t, err)
+
+		var contact *Contact
+		contact, err = client.UpdateContact(context.Background(), id, &ContactFields{})
+		assert.Nil(t, contact)
+		assert.ErrorIs(t, err, ErrMissingContactAttributes)
+	})
+
+	t.Run("error responses", func(t *testing.T) {
+		id, err := strconv.ParseUint(testContactID, 10, 64)
+		assert.NoError(t, err)
+
+		tests := []struct {
+			statusCode  int
+			expectedErr error
+		}{
+			{http.StatusBadRequest, ErrBadRequest},
+			{http.StatusUnauthorized, ErrUnauthorized},
+			{http.StatusNotFound, ErrResourceNotFound},
+			{http.StatusTooManyRequests, ErrTooManyRequests},
+		}
+		for _, test := range tests {
+			client := newTestClient(&mockHTTPResponse{statusCode: test.statusCode})
+
+			var contact *Contact
+			contact, err = client.UpdateContact(
+				context.Background(), id,
+				&ContactFields{Attributes: &StandardAttributes{Name: testContactName}})
+			assert.Nil(t, contact)
+			assert.ErrorIs(t, err, test.expectedErr)
+		}
+	})
+
+	t.Run("bad json response", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{body: `{"data":`, statusCode: http.StatusOK})
+
+		id, err := strconv.ParseUint(testContactID, 10, 64)
+		assert.NoError(t, err)
+
+		_, err = client.UpdateContact(
+			context.Background(), id,
+			&ContactFields{Attributes: &StandardAttributes{Name: testContactName}})
+		assert.ErrorIs(t, err, ErrDecodeResponse)
+	})
 }
 
 // BenchmarkClient_UpdateContact benchmarks the UpdateContact method
 func BenchmarkClient_UpdateContact(b *testing.B) {
 	client := newTestClient(&mockHTTPCreateContact{})
 	id, _ := strconv.ParseUint(testContactID, 10, 64)
-	fields := &ContactFields{&StandardAttributes{
+	fields := &ContactFields{Attributes: &StandardAttributes{
 		Email: testContactEmail,
 		Name:  testContactName,
 		Phone: testContactPhone,
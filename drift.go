@@ -1,7 +1,24 @@
 // Package drift is an unofficial Go version of Drift's API
 //
+// Queries and request bodies are validated (see the Validate methods) before any
+// request is made, so invalid input fails fast with one of the Err* errors.
+//
 // If you have any suggestions or comments, please feel free to open an issue on
 // this GitHub repository!
 //
 // By @MrZ1836
 package drift
+
+import "encoding/json"
+
+// Marshaler is used to encode all request bodies (defaults to encoding/json)
+//
+// Replace it (once, before using any client) to plug in a faster JSON library
+// with the same signature, ie: jsoniter.ConfigCompatibleWithStandardLibrary.Marshal
+var Marshaler = json.Marshal
+
+// Unmarshaler is used to decode all response bodies (defaults to encoding/json)
+//
+// Replace it (once, before using any client) to plug in a faster JSON library
+// with the same signature, ie: jsoniter.ConfigCompatibleWithStandardLibrary.Unmarshal
+var Unmarshaler = json.Unmarshal
@@ -0,0 +1,73 @@
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMarshalers tests replacing the JSON Marshaler and Unmarshaler
+// (not parallel: the seams are package-level)
+func TestMarshalers(t *testing.T) {
+	defer func() {
+		Marshaler = json.Marshal
+		Unmarshaler = json.Unmarshal
+	}()
+
+	var marshaled, unmarshaled int
+	Marshaler = func(v interface{}) ([]byte, error) {
+		marshaled++
+		return json.Marshal(v)
+	}
+	Unmarshaler = func(data []byte, v interface{}) error {
+		unmarshaled++
+		return json.Unmarshal(data, v)
+	}
+
+	client := newTestClient(&mockHTTPUpdateContact{})
+	id, err := strconv.ParseUint(testContactID, 10, 64)
+	assert.NoError(t, err)
+
+	var contact *Contact
+	contact, err = client.UpdateContact(context.Background(), id, &ContactFields{
+		Attributes: &StandardAttributes{Name: testContactName + "2"},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, contact)
+	assert.Equal(t, 1, marshaled)
+	assert.Equal(t, 1, unmarshaled)
+}
+
+// BenchmarkUnmarshaler_GetContacts benchmarks decoding a contact (GetContacts)
+// with the default Unmarshaler and with a replaced one
+func BenchmarkUnmarshaler_GetContacts(b *testing.B) {
+	client := newTestClient(&mockHTTPGetContacts{})
+	fields := &ContactQuery{
+		ID: testContactID,
+	}
+
+	b.Run("default", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := client.GetContacts(context.Background(), fields); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("replaced", func(b *testing.B) {
+		defer func() {
+			Unmarshaler = json.Unmarshal
+		}()
+		Unmarshaler = func(data []byte, v interface{}) error {
+			return json.Unmarshal(data, v)
+		}
+		for i := 0; i < b.N; i++ {
+			if _, err := client.GetContacts(context.Background(), fields); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package drift
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Validation errors (returned before any request is made)
+var (
+	// ErrAmbiguousContactQuery is when a contact query has more than one identifier
+	ErrAmbiguousContactQuery = errors.New("only one of contact id, email or external id can be used")
+
+	// ErrInvalidLimit is when a query limit is negative
+	ErrInvalidLimit = errors.New("limit cannot be negative")
+
+	// ErrMissingContactAttributes is when there are no contact attributes to create or update
+	ErrMissingContactAttributes = errors.New("contact attributes are required")
+
+	// ErrMissingContactID is when a contact id is required but not given
+	ErrMissingContactID = errors.New("contact id is required")
+
+	// ErrMissingContactIdentifier is when a contact query has nothing to search for
+	ErrMissingContactIdentifier = errors.New("contact id, email or external id is required")
+
+	// ErrMissingContactTags is when there are no tags to add or remove
+	ErrMissingContactTags = errors.New("at least one contact tag is required")
+
+	// ErrMissingEventName is when a timeline event has no event name
+	ErrMissingEventName = errors.New("event name is required")
+
+	// ErrMissingExternalID is when an external id is required but not given
+	ErrMissingExternalID = errors.New("external id is required")
+)
+
+// Response errors (wrapped with the request details, use errors.Is to check)
+//
+// Errors from the HTTP client itself (ie: connection failures, context.Canceled or
+// context.DeadlineExceeded) are returned as-is
+var (
+	// ErrBadRequest is when the API rejects the request data (400)
+	ErrBadRequest = errors.New("malformatted request data")
+
+	// ErrConflict is when the record cannot be created or updated (409)
+	ErrConflict = errors.New("issue with creating or updating record, possibly already exists")
+
+	// ErrDecodeResponse is when the response body cannot be decoded (see: Unmarshaler)
+	ErrDecodeResponse = errors.New("failed to decode response")
+
+	// ErrEmptyResponse is when the response has no "data" (see: RequestResponse.DecodeData)
+	ErrEmptyResponse = errors.New("response is missing data")
+
+	// ErrOperationFailed is when the request succeeded but the operation did not ("ok":false)
+	ErrOperationFailed = errors.New("operation failed")
+
+	// ErrResourceNotFound is when the requested resource does not exist (404)
+	ErrResourceNotFound = errors.New("resource not found")
+
+	// ErrTooManyRequests is when the API rate limit is exceeded (429, see: RateLimitError)
+	ErrTooManyRequests = errors.New("too many requests, rate limit exceeded")
+
+	// ErrTruncatedResponse is when the response body is shorter than its Content-Length
+	ErrTruncatedResponse = errors.New("truncated response body")
+
+	// ErrUnauthorized is when the OAuth access token is rejected (401)
+	ErrUnauthorized = errors.New("oauth access token possible invalid or missing")
+
+	// ErrUnexpectedStatus is when the response status is not the expected status
+	ErrUnexpectedStatus = errors.New("unexpected status code")
+)
+
+// RateLimitError is the error for a 429 response, with the rate limit details (if sent by the API)
+//
+// errors.Is(err, ErrTooManyRequests) is true, use errors.As to get the details
+type RateLimitError struct {
+	Limit      int           `json:"limit"`       // Requests allowed in the window (X-RateLimit-Limit)
+	Remaining  int           `json:"remaining"`   // Requests remaining in the window (X-RateLimit-Remaining)
+	RetryAfter time.Duration `json:"retry_after"` // How long to wait before retrying (Retry-After)
+}
+
+// Error will return the error message
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s: retry after %s", ErrTooManyRequests.Error(), e.RetryAfter)
+	}
+	return ErrTooManyRequests.Error()
+}
+
+// Unwrap will return ErrTooManyRequests
+func (e *RateLimitError) Unwrap() error {
+	return ErrTooManyRequests
+}
+
+// decodeError is the error for a response body that cannot be decoded
+//
+// errors.Is(err, ErrDecodeResponse) is true, and errors.As still finds the Unmarshaler error (ie: *json.SyntaxError)
+type decodeError struct {
+	err error
+}
+
+// Error will return the error message
+func (e *decodeError) Error() string {
+	return ErrDecodeResponse.Error() + ": " + e.err.Error()
+}
+
+// Is will return true for ErrDecodeResponse
+func (e *decodeError) Is(target error) bool {
+	return target == ErrDecodeResponse
+}
+
+// Unwrap will return the Unmarshaler error
+func (e *decodeError) Unwrap() error {
+	return e.err
+}
+
+// Custom attribute errors (see: ClientOptions.ValidateCustomAttributes)
+var (
+	// ErrInvalidCustomAttribute is when a custom attribute value does not match its type
+	ErrInvalidCustomAttribute = errors.New("invalid custom attribute value")
+
+	// ErrUnknownCustomAttribute is when a custom attribute has no definition
+	ErrUnknownCustomAttribute = errors.New("custom attribute not found")
+)
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/mrz1836/go-drift"
+)
+
+func main() {
+
+	// Create a new client
+	client := drift.NewClient(
+		os.Getenv("TEST_DRIFT_OAUTH_TOKEN"), nil, nil,
+	)
+
+	// Parse our env string into a number (just for this example)
+	id, _ := strconv.ParseUint(os.Getenv("TEST_DRIFT_CONTACT_ID"), 10, 64)
+
+	// Delete the contact
+	result, err := client.DeleteContact(context.Background(), id)
+	if err != nil {
+		log.Fatal("failed: ", err.Error())
+		return
+	}
+
+	// See the result
+	log.Println(result.OK)
+	log.Println(result.Result)
+}
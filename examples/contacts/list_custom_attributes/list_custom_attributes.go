@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/mrz1836/go-drift"
+)
+
+func main() {
+
+	// Create a new client
+	client := drift.NewClient(
+		os.Getenv("TEST_DRIFT_OAUTH_TOKEN"), nil, nil,
+	)
+
+	// List all the contact attribute definitions
+	attributes, err := client.ListCustomAttributes(context.Background())
+	if err != nil {
+		log.Fatal("failed: ", err.Error())
+		return
+	}
+
+	// See the attribute definitions
+	for _, property := range attributes.Data.Properties {
+		log.Println(property.Name, property.DisplayName, property.Type)
+	}
+}
@@ -3,20 +3,85 @@ package drift
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 // RequestResponse is the response from a request
 type RequestResponse struct {
-	BodyContents []byte `json:"body_contents"` // Raw body response
-	Error        error  `json:"error"`         // If an error occurs
-	Method       string `json:"method"`        // Method is the HTTP method used
-	PostData     string `json:"post_data"`     // PostData is the post data submitted if POST/PUT request
-	StatusCode   int    `json:"status_code"`   // StatusCode is the last code from the request
-	URL          string `json:"url"`           // URL is used for the request
+	BodyContents    []byte    `json:"body_contents"`    // Raw body response
+	Deprecated      bool      `json:"deprecated"`       // Deprecated is true if the endpoint sent a Deprecation header
+	Error           error     `json:"error"`            // If an error occurs
+	Method          string    `json:"method"`           // Method is the HTTP method used
+	PostData        string    `json:"post_data"`        // PostData is the post data submitted if POST/PUT request
+	RedirectHistory []string  `json:"redirect_history"` // RedirectHistory is each URL redirected to (default HTTP client only)
+	StatusCode      int       `json:"status_code"`      // StatusCode is the last code from the request
+	SunsetDate      time.Time `json:"sunset_date"`      // SunsetDate is when the endpoint will be removed (Sunset header)
+	URL             string    `json:"url"`              // URL is used for the request
+}
+
+// redirectHistoryKey is the context key for collecting the redirect history of a request
+type redirectHistoryKey struct{}
+
+// acceptableStatusesKey is the context key for the extra status codes accepted by a request
+type acceptableStatusesKey struct{}
+
+// WithAcceptableStatuses will return a context where the given status codes are not errors
+// for requests made with it (ie: a 404 when checking if a contact exists)
+//
+// The response is returned as-is (check RequestResponse.StatusCode), best used with the Raw methods.
+// Typed methods still decode the body, so they fail to decode most accepted error responses
+// (GetContact returns ErrResourceNotFound when there is no contact data)
+func WithAcceptableStatuses(ctx context.Context, statusCodes ...int) context.Context {
+	return context.WithValue(ctx, acceptableStatusesKey{}, statusCodes)
+}
+
+// isAcceptableStatus will return true if the status code was accepted for the request (see: WithAcceptableStatuses)
+func isAcceptableStatus(ctx context.Context, statusCode int) bool {
+	statusCodes, _ := ctx.Value(acceptableStatusesKey{}).([]int)
+	for _, code := range statusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// DecodeData will decode the "data" envelope of the response body into v
+//
+// Returns ErrEmptyResponse if the body is empty or "data" is missing or null (instead of leaving v as a zero value)
+// and ErrDecodeResponse if the body cannot be decoded
+func (r *RequestResponse) DecodeData(v interface{}) error {
+	if len(r.BodyContents) == 0 {
+		return ErrEmptyResponse
+	}
+	envelope := new(struct {
+		Data json.RawMessage `json:"data"`
+	})
+	if err := decodeResponse(r.BodyContents, envelope); err != nil {
+		return err
+	}
+	if len(envelope.Data) == 0 || string(envelope.Data) == "null" {
+		return ErrEmptyResponse
+	}
+	return decodeResponse(envelope.Data, v)
+}
+
+// decodeResponse will decode a response body into v (see: decodeError)
+func decodeResponse(data []byte, v interface{}) error {
+	if err := Unmarshaler(data, v); err != nil {
+		return &decodeError{err: err}
+	}
+	return nil
 }
 
 // httpPayload is used for a httpRequest
@@ -27,10 +92,154 @@ type httpPayload struct {
 	URL            string `json:"url"`
 }
 
+// flight is a single in-flight request (shared by identical requests)
+type flight struct {
+	callers  int                // Number of callers still waiting for the request
+	cancel   context.CancelFunc // Cancels the request (once no one is waiting, or once done)
+	done     chan struct{}      // Closed once the request is finished
+	panicked interface{}        // Recovered panic of the request (re-panics in each caller)
+	response *RequestResponse   // Response of the request (only set once done)
+}
+
+// flightGroup holds the in-flight requests by key (the zero value is ready to use)
+type flightGroup struct {
+	flights map[string]*flight
+	lock    sync.Mutex
+}
+
+// flightContext keeps the values of the first caller's context but not its deadline or cancellation,
+// so one caller giving up does not fail the request for everyone else
+type flightContext struct {
+	context.Context
+}
+
+// Deadline will return no deadline (see: flightContext)
+func (flightContext) Deadline() (deadline time.Time, ok bool) {
+	return
+}
+
+// Done will return nil (never canceled)
+func (flightContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Err will return nil (never canceled)
+func (flightContext) Err() error {
+	return nil
+}
+
+// do will run the request once for all concurrent callers with the same key,
+// each caller gets its own copy of the response
+//
+// Each caller waits with its own context (returning ctx.Err() if done first) while the request
+// keeps running for the others. The request is canceled once every caller gave up, and is limited
+// by the timeout (if set)
+func (g *flightGroup) do(ctx context.Context, key string, timeout time.Duration,
+	request func(ctx context.Context) *RequestResponse) (*RequestResponse, error) {
+
+	// Join the request, or start it if it is not in-flight
+	g.lock.Lock()
+	if g.flights == nil {
+		g.flights = make(map[string]*flight)
+	}
+	current, ok := g.flights[key]
+	if !ok {
+		var flightCtx context.Context
+		current = &flight{done: make(chan struct{})}
+		if timeout > 0 {
+			flightCtx, current.cancel = context.WithTimeout(flightContext{ctx}, timeout)
+		} else {
+			flightCtx, current.cancel = context.WithCancel(flightContext{ctx})
+		}
+		g.flights[key] = current
+		go g.run(flightCtx, key, current, request)
+	}
+	current.callers++
+	g.lock.Unlock()
+
+	// Wait for the request (or give up)
+	select {
+	case <-current.done:
+		if current.panicked != nil {
+			panic(current.panicked)
+		}
+		return current.response.copy(), nil
+	case <-ctx.Done():
+		g.leave(key, current)
+		return nil, ctx.Err()
+	}
+}
+
+// leave will remove a caller that gave up, the request is canceled (and forgotten) if no one is waiting
+func (g *flightGroup) leave(key string, current *flight) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if current.callers--; current.callers > 0 {
+		return
+	}
+	if g.flights[key] == current {
+		delete(g.flights, key)
+	}
+	current.cancel()
+}
+
+// run will fire the request and release everyone waiting (even if the request panics)
+func (g *flightGroup) run(ctx context.Context, key string, current *flight,
+	request func(ctx context.Context) *RequestResponse) {
+	defer func() {
+		current.panicked = recover()
+		g.lock.Lock()
+		if g.flights[key] == current {
+			delete(g.flights, key)
+		}
+		g.lock.Unlock()
+		current.cancel()
+		close(current.done)
+	}()
+	current.response = request(ctx)
+}
+
+// copy will return a copy of the response (nothing shared with the original)
+func (r *RequestResponse) copy() *RequestResponse {
+	response := *r
+	if r.BodyContents != nil {
+		response.BodyContents = append([]byte{}, r.BodyContents...)
+	}
+	if r.RedirectHistory != nil {
+		response.RedirectHistory = append([]string{}, r.RedirectHistory...)
+	}
+	return &response
+}
+
 // httpRequest is a generic request wrapper that can be used without constraints
+//
+// With ClientOptions.SingleFlight, identical concurrent GET requests (method, url and accepted statuses)
+// share one call (the access token is not part of the key). Requests are not shared when a
+// ClientOptions.RequestModifier is set, since it could change each request based on its context.
 func httpRequest(ctx context.Context, client *Client,
 	payload *httpPayload) (response *RequestResponse) {
 
+	if client.Options.SingleFlight && client.Options.RequestModifier == nil &&
+		payload.Method == http.MethodGet {
+		statusCodes, _ := ctx.Value(acceptableStatusesKey{}).([]int)
+		var err error
+		if response, err = client.flights.do(
+			ctx, fmt.Sprintf("%s %s %v", payload.Method, payload.URL, statusCodes), client.Options.RequestTimeout,
+			func(ctx context.Context) *RequestResponse {
+				return sendRequest(ctx, client, payload)
+			},
+		); err != nil {
+			response = &RequestResponse{Error: err, Method: payload.Method, URL: payload.URL}
+		}
+		return
+	}
+	return sendRequest(ctx, client, payload)
+}
+
+// sendRequest will create and fire the request
+func sendRequest(ctx context.Context, client *Client,
+	payload *httpPayload) (response *RequestResponse) {
+
 	// Set reader
 	var bodyReader io.Reader
 
@@ -47,6 +256,9 @@ func httpRequest(ctx context.Context, client *Client,
 	response.Method = payload.Method
 	response.URL = payload.URL
 
+	// Collect any redirects (recorded by the default HTTP client)
+	ctx = context.WithValue(ctx, redirectHistoryKey{}, &response.RedirectHistory)
+
 	// Start the request
 	var request *http.Request
 	if request, response.Error = http.NewRequestWithContext(
@@ -68,6 +280,18 @@ func httpRequest(ctx context.Context, client *Client,
 		request.Header.Set("Authorization", "Bearer "+client.OAuthAccessToken)
 	}
 
+	// Let the client modify the request (after all the headers are set)
+	if client.Options.RequestModifier != nil {
+		if response.Error = client.Options.RequestModifier(ctx, request); response.Error != nil {
+			return
+		}
+	}
+
+	// Emit the request as a curl command (debugging)
+	if client.Options.CurlDebug != nil {
+		client.Options.CurlDebug(curlCommand(request, payload.Data))
+	}
+
 	// Fire the http request
 	var resp *http.Response
 	if resp, response.Error = client.httpClient.Do(request); response.Error != nil {
@@ -87,20 +311,25 @@ func httpRequest(ctx context.Context, client *Client,
 	// Set the status
 	response.StatusCode = resp.StatusCode
 
+	// Check for a deprecated endpoint (RFC 9745 & RFC 8594)
+	response.Deprecated, response.SunsetDate = parseDeprecation(resp.Header)
+
 	// Check status code
-	if payload.ExpectedStatus != resp.StatusCode {
+	if payload.ExpectedStatus != resp.StatusCode && !isAcceptableStatus(ctx, resp.StatusCode) {
 		switch resp.StatusCode {
 		case http.StatusNotFound:
-			response.Error = fmt.Errorf("resource not found: %s", response.URL)
+			response.Error = fmt.Errorf("%w: %s", ErrResourceNotFound, response.URL)
 		case http.StatusUnauthorized:
-			response.Error = fmt.Errorf("oauth access token possible invalid or missing")
+			response.Error = ErrUnauthorized
 		case http.StatusBadRequest:
-			response.Error = fmt.Errorf("malformatted request data")
+			response.Error = ErrBadRequest
 		case http.StatusConflict:
-			response.Error = fmt.Errorf("issue with creating or updating record, possibly already exists")
+			response.Error = ErrConflict
+		case http.StatusTooManyRequests:
+			response.Error = parseRateLimit(resp.Header)
 		default:
 			response.Error = fmt.Errorf(
-				"status code: %d does not match %d",
+				"%w: %d does not match %d", ErrUnexpectedStatus,
 				resp.StatusCode, payload.ExpectedStatus,
 			)
 		}
@@ -108,7 +337,79 @@ func httpRequest(ctx context.Context, client *Client,
 	}
 
 	// Read the body
-	response.BodyContents, response.Error = ioutil.ReadAll(resp.Body)
+	if response.BodyContents, response.Error = ioutil.ReadAll(resp.Body); response.Error != nil {
+		if errors.Is(response.Error, io.ErrUnexpectedEOF) {
+			response.Error = fmt.Errorf("%w: %s", ErrTruncatedResponse, response.Error.Error())
+		}
+		return
+	}
 
+	// Make sure the full body was received (partial JSON can decode without an error)
+	if resp.ContentLength > 0 && int64(len(response.BodyContents)) != resp.ContentLength {
+		response.Error = fmt.Errorf(
+			"%w: read %d of %d bytes", ErrTruncatedResponse,
+			len(response.BodyContents), resp.ContentLength,
+		)
+	}
+
+	return
+}
+
+// curlCommand will format the request as a copy-pasteable curl command (the access token is redacted)
+func curlCommand(request *http.Request, data []byte) string {
+	command := []string{"curl", "-X", request.Method, shellQuote(request.URL.String())}
+
+	// Sort the headers for a consistent command
+	names := make([]string, 0, len(request.Header))
+	for name := range request.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range request.Header[name] {
+			if name == "Authorization" {
+				value = "Bearer [REDACTED]"
+			}
+			command = append(command, "-H", shellQuote(name+": "+value))
+		}
+	}
+
+	// Add the body (if any)
+	if request.Body != nil && len(data) > 0 {
+		command = append(command, "-d", shellQuote(string(data)))
+	}
+	return strings.Join(command, " ")
+}
+
+// shellQuote will single-quote the value for a POSIX shell
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// parseDeprecation will parse the Deprecation and Sunset headers
+func parseDeprecation(header http.Header) (deprecated bool, sunset time.Time) {
+	if value := strings.TrimSpace(header.Get("Deprecation")); len(value) > 0 {
+		deprecated = !strings.EqualFold(value, "false")
+	}
+	if value := header.Get("Sunset"); len(value) > 0 {
+		sunset, _ = http.ParseTime(value)
+	}
 	return
 }
+
+// parseRateLimit will parse the rate limit headers of a 429 response
+func parseRateLimit(header http.Header) *RateLimitError {
+	rateLimit := new(RateLimitError)
+	rateLimit.Limit, _ = strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	rateLimit.Remaining, _ = strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+
+	// Retry-After is either seconds or a date
+	if value := strings.TrimSpace(header.Get("Retry-After")); len(value) > 0 {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+			rateLimit.RetryAfter = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(value); err == nil && time.Until(date) > 0 {
+			rateLimit.RetryAfter = time.Until(date).Round(time.Second)
+		}
+	}
+	return rateLimit
+}
@@ -0,0 +1,661 @@
+package drift
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockHTTPResponse for mocking a response (status code, headers and body)
+type mockHTTPResponse struct {
+	body       string
+	header     http.Header
+	statusCode int
+}
+
+// Do is a mock http request
+func (m *mockHTTPResponse) Do(_ *http.Request) (*http.Response, error) {
+	resp := &http.Response{Body: http.NoBody, Header: m.header, StatusCode: m.statusCode}
+	if len(m.body) > 0 {
+		resp.Body = ioutil.NopCloser(bytes.NewBufferString(m.body))
+	}
+	return resp, nil
+}
+
+// TestHTTPRequest_Errors tests the errors returned for each response status
+func TestHTTPRequest_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		statusCode  int
+		expectedErr error
+	}{
+		{http.StatusBadRequest, ErrBadRequest},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusNotFound, ErrResourceNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusInternalServerError, ErrUnexpectedStatus},
+	}
+	for _, test := range tests {
+		t.Run(http.StatusText(test.statusCode), func(t *testing.T) {
+			client := newTestClient(&mockHTTPResponse{statusCode: test.statusCode})
+
+			response := httpRequest(context.Background(), client, &httpPayload{
+				ExpectedStatus: http.StatusOK,
+				Method:         http.MethodGet,
+				URL:            apiEndpoint + "/contacts/" + testContactID,
+			})
+			assert.ErrorIs(t, response.Error, test.expectedErr)
+			assert.Equal(t, test.statusCode, response.StatusCode)
+		})
+	}
+
+	t.Run("not found includes the url", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{statusCode: http.StatusNotFound})
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            apiEndpoint + "/contacts/" + testContactID,
+		})
+		assert.Contains(t, response.Error.Error(), apiEndpoint+"/contacts/"+testContactID)
+	})
+}
+
+// TestWithAcceptableStatuses tests accepting extra status codes for a request
+func TestWithAcceptableStatuses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepted status is not an error", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{statusCode: http.StatusNotFound})
+
+		response, err := client.GetContactsRaw(
+			WithAcceptableStatuses(context.Background(), http.StatusNotFound), &ContactQuery{ID: testContactID},
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, response.Error)
+		assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	})
+
+	t.Run("other status is still an error", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{statusCode: http.StatusUnauthorized})
+
+		response, err := client.GetContactsRaw(
+			WithAcceptableStatuses(context.Background(), http.StatusNotFound), &ContactQuery{ID: testContactID},
+		)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+	})
+
+	t.Run("typed method reports not found", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{statusCode: http.StatusNotFound})
+
+		contact, err := client.GetContact(WithAcceptableStatuses(context.Background(), http.StatusNotFound), 123456789)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+		assert.Nil(t, contact)
+	})
+
+	t.Run("typed method fails to decode", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{statusCode: http.StatusNotFound})
+
+		contacts, err := client.GetContacts(
+			WithAcceptableStatuses(context.Background(), http.StatusNotFound), &ContactQuery{ID: testContactID},
+		)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrResourceNotFound)
+		assert.Nil(t, contacts)
+	})
+}
+
+// TestClientOptions_CurlDebug tests emitting each request as a curl command
+func TestClientOptions_CurlDebug(t *testing.T) {
+	t.Parallel()
+
+	t.Run("post request with the token redacted", func(t *testing.T) {
+		var curl string
+		client := newTestClient(&mockHTTPResponse{statusCode: http.StatusOK})
+		client.Options.CurlDebug = func(command string) {
+			curl = command
+		}
+
+		_ = httpRequest(context.Background(), client, &httpPayload{
+			Data:           []byte(`{"attributes":{"name":"John O'Doe"}}`),
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodPost,
+			URL:            apiEndpoint + "/contacts",
+		})
+		assert.Equal(t, `curl -X POST '`+apiEndpoint+`/contacts' -H 'Authorization: Bearer [REDACTED]' -H 'Content-Type: application/json' -H 'User-Agent: `+defaultUserAgent+`' -d '{"attributes":{"name":"John O'\''Doe"}}'`, curl)
+		assert.NotContains(t, curl, testDataOAuthToken)
+	})
+
+	t.Run("get request", func(t *testing.T) {
+		var curl string
+		client := newTestClient(&mockHTTPResponse{statusCode: http.StatusOK})
+		client.Options.CurlDebug = func(command string) {
+			curl = command
+		}
+
+		_ = httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            apiEndpoint + "/contacts/" + testContactID,
+		})
+		assert.Equal(t, `curl -X GET '`+apiEndpoint+`/contacts/`+testContactID+`' -H 'Authorization: Bearer [REDACTED]' -H 'User-Agent: `+defaultUserAgent+`'`, curl)
+	})
+}
+
+// TestHTTPRequest_TruncatedResponse tests detecting a body shorter than its Content-Length
+func TestHTTPRequest_TruncatedResponse(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "100")
+		if r.URL.Path == "/truncated" {
+			_, _ = w.Write([]byte(`{"data":{"id":1`))
+			return
+		}
+		_, _ = w.Write(bytes.Repeat([]byte(" "), 100))
+	}))
+	defer server.Close()
+
+	t.Run("truncated body", func(t *testing.T) {
+		client := NewClient(testDataOAuthToken, nil, nil)
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            server.URL + "/truncated",
+		})
+		assert.ErrorIs(t, response.Error, ErrTruncatedResponse)
+	})
+
+	t.Run("complete body", func(t *testing.T) {
+		client := NewClient(testDataOAuthToken, nil, nil)
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            server.URL + "/complete",
+		})
+		assert.NoError(t, response.Error)
+		assert.Equal(t, 100, len(response.BodyContents))
+	})
+
+	t.Run("mismatched content length", func(t *testing.T) {
+		client := newTestClient(&mockHTTPTruncated{})
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            apiEndpoint + "/contacts/" + testContactID,
+		})
+		assert.ErrorIs(t, response.Error, ErrTruncatedResponse)
+	})
+}
+
+// mockHTTPTruncated for mocking a response shorter than its Content-Length
+type mockHTTPTruncated struct{}
+
+// Do is a mock http request
+func (m *mockHTTPTruncated) Do(_ *http.Request) (*http.Response, error) {
+	return &http.Response{
+		Body:          ioutil.NopCloser(bytes.NewBufferString(`{"data":{}}`)),
+		ContentLength: 100,
+		StatusCode:    http.StatusOK,
+	}, nil
+}
+
+// TestRequestResponse_DecodeData tests the method DecodeData()
+func TestRequestResponse_DecodeData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("decode the data", func(t *testing.T) {
+		response := &RequestResponse{BodyContents: []byte(`{"data":{"id":` + testContactID + `,"createdAt":1606273669631}}`)}
+		data := new(contactData)
+		err := response.DecodeData(data)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(123456789), data.ID)
+		assert.Equal(t, int64(1606273669631), data.CreatedAt)
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		response := &RequestResponse{}
+		err := response.DecodeData(new(contactData))
+		assert.ErrorIs(t, err, ErrEmptyResponse)
+	})
+
+	t.Run("missing data", func(t *testing.T) {
+		response := &RequestResponse{BodyContents: []byte(`{}`)}
+		err := response.DecodeData(new(contactData))
+		assert.ErrorIs(t, err, ErrEmptyResponse)
+	})
+
+	t.Run("null data", func(t *testing.T) {
+		response := &RequestResponse{BodyContents: []byte(`{"data":null}`)}
+		err := response.DecodeData(new(contactData))
+		assert.ErrorIs(t, err, ErrEmptyResponse)
+	})
+
+	t.Run("bad json", func(t *testing.T) {
+		response := &RequestResponse{BodyContents: []byte(`{"data":`)}
+		err := response.DecodeData(new(contactData))
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrEmptyResponse)
+	})
+}
+
+// TestHTTPRequest_Deprecation tests parsing the Deprecation and Sunset headers
+func TestHTTPRequest_Deprecation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		header     http.Header
+		deprecated bool
+		sunset     time.Time
+	}{
+		{"no headers", http.Header{}, false, time.Time{}},
+		{"deprecated with a date", http.Header{"Deprecation": {"@1688169599"}}, true, time.Time{}},
+		{"deprecated (draft format)", http.Header{"Deprecation": {"true"}}, true, time.Time{}},
+		{"not deprecated", http.Header{"Deprecation": {"false"}}, false, time.Time{}},
+		{"deprecated with a sunset", http.Header{
+			"Deprecation": {"true"},
+			"Sunset":      {"Sat, 31 Dec 2033 23:59:59 GMT"},
+		}, true, time.Date(2033, 12, 31, 23, 59, 59, 0, time.UTC)},
+		{"invalid sunset", http.Header{"Sunset": {"tomorrow"}}, false, time.Time{}},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			client := newTestClient(&mockHTTPResponse{header: test.header, statusCode: http.StatusOK})
+
+			response := httpRequest(context.Background(), client, &httpPayload{
+				ExpectedStatus: http.StatusOK,
+				Method:         http.MethodGet,
+				URL:            apiEndpoint + "/contacts/" + testContactID,
+			})
+			assert.NoError(t, response.Error)
+			assert.Equal(t, test.deprecated, response.Deprecated)
+			assert.True(t, test.sunset.Equal(response.SunsetDate))
+		})
+	}
+}
+
+// mockHTTPCapture for capturing the request
+type mockHTTPCapture struct {
+	request *http.Request
+}
+
+// Do is a mock http request
+func (m *mockHTTPCapture) Do(req *http.Request) (*http.Response, error) {
+	m.request = req
+	return &http.Response{Body: http.NoBody, StatusCode: http.StatusOK}, nil
+}
+
+// TestHTTPRequest_Body tests the request body is length-delimited and replayable
+func TestHTTPRequest_Body(t *testing.T) {
+	t.Parallel()
+
+	t.Run("post body", func(t *testing.T) {
+		mock := &mockHTTPCapture{}
+		client := newTestClient(mock)
+		data := []byte(`{"attributes":{"name":"` + testContactName + `"}}`)
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			Data:           data,
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodPost,
+			URL:            apiEndpoint + "/contacts",
+		})
+		assert.NoError(t, response.Error)
+		assert.Equal(t, int64(len(data)), mock.request.ContentLength)
+		assert.NotNil(t, mock.request.GetBody)
+
+		// Read the body and then replay it
+		body, err := ioutil.ReadAll(mock.request.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, data, body)
+
+		replay, err := mock.request.GetBody()
+		assert.NoError(t, err)
+		body, err = ioutil.ReadAll(replay)
+		assert.NoError(t, err)
+		assert.Equal(t, data, body)
+	})
+
+	t.Run("get has no body", func(t *testing.T) {
+		mock := &mockHTTPCapture{}
+		client := newTestClient(mock)
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            apiEndpoint + "/contacts/" + testContactID,
+		})
+		assert.NoError(t, response.Error)
+		assert.Equal(t, int64(0), mock.request.ContentLength)
+		assert.Nil(t, mock.request.GetBody)
+	})
+}
+
+// TestClientOptions_RequestModifier tests modifying each request before it is sent
+func TestClientOptions_RequestModifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("modify the request", func(t *testing.T) {
+		mock := &mockHTTPCapture{}
+		client := newTestClient(mock)
+		client.Options.RequestModifier = func(_ context.Context, req *http.Request) error {
+			assert.Equal(t, defaultUserAgent, req.Header.Get("User-Agent"))
+			req.Header.Set("X-Signature", "signed")
+			return nil
+		}
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            apiEndpoint + "/contacts/" + testContactID,
+		})
+		assert.NoError(t, response.Error)
+		assert.Equal(t, "signed", mock.request.Header.Get("X-Signature"))
+	})
+
+	t.Run("modifier error aborts the request", func(t *testing.T) {
+		mock := &mockHTTPCapture{}
+		client := newTestClient(mock)
+		modifierErr := fmt.Errorf("failed to sign")
+		client.Options.RequestModifier = func(context.Context, *http.Request) error {
+			return modifierErr
+		}
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            apiEndpoint + "/contacts/" + testContactID,
+		})
+		assert.ErrorIs(t, response.Error, modifierErr)
+		assert.Nil(t, mock.request)
+	})
+}
+
+// TestHTTPRequest_RateLimit tests the error returned for a 429 response
+func TestHTTPRequest_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		header     http.Header
+		limit      int
+		remaining  int
+		retryAfter time.Duration
+	}{
+		{"no headers", http.Header{}, 0, 0, 0},
+		{"retry after seconds", http.Header{
+			"Retry-After":           {"30"},
+			"X-Ratelimit-Limit":     {"600"},
+			"X-Ratelimit-Remaining": {"0"},
+		}, 600, 0, 30 * time.Second},
+		{"retry after a date in the past", http.Header{"Retry-After": {"Sat, 01 Jan 2000 00:00:00 GMT"}}, 0, 0, 0},
+		{"invalid retry after", http.Header{"Retry-After": {"soon"}}, 0, 0, 0},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			client := newTestClient(&mockHTTPResponse{header: test.header, statusCode: http.StatusTooManyRequests})
+
+			response := httpRequest(context.Background(), client, &httpPayload{
+				ExpectedStatus: http.StatusOK,
+				Method:         http.MethodGet,
+				URL:            apiEndpoint + "/contacts/" + testContactID,
+			})
+			assert.ErrorIs(t, response.Error, ErrTooManyRequests)
+
+			var rateLimitErr *RateLimitError
+			assert.ErrorAs(t, response.Error, &rateLimitErr)
+			assert.Equal(t, test.limit, rateLimitErr.Limit)
+			assert.Equal(t, test.remaining, rateLimitErr.Remaining)
+			assert.Equal(t, test.retryAfter, rateLimitErr.RetryAfter)
+		})
+	}
+
+	t.Run("retry after a date", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{statusCode: http.StatusTooManyRequests, header: http.Header{
+			"Retry-After": {time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)},
+		}})
+
+		response := httpRequest(context.Background(), client, &httpPayload{
+			ExpectedStatus: http.StatusOK,
+			Method:         http.MethodGet,
+			URL:            apiEndpoint + "/contacts/" + testContactID,
+		})
+		var rateLimitErr *RateLimitError
+		assert.ErrorAs(t, response.Error, &rateLimitErr)
+		assert.InDelta(t, float64(time.Minute), float64(rateLimitErr.RetryAfter), float64(2*time.Second))
+		assert.Contains(t, rateLimitErr.Error(), "retry after")
+	})
+}
+
+// mockHTTPGated for mocking requests that wait until the gate is opened (or the request is canceled)
+type mockHTTPGated struct {
+	calls    int32
+	canceled int32
+	gate     chan struct{}
+}
+
+// Do is a mock http request (counts each call)
+func (m *mockHTTPGated) Do(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&m.calls, 1)
+	select {
+	case <-m.gate:
+		return (&mockHTTPGetContacts{}).Do(req)
+	case <-req.Context().Done():
+		atomic.AddInt32(&m.canceled, 1)
+		return nil, req.Context().Err()
+	}
+}
+
+// waitUntil will wait until the condition is true (or fail the test)
+func waitUntil(t *testing.T, condition func() bool) {
+	for start := time.Now(); !condition(); time.Sleep(time.Millisecond) {
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("timed out waiting for the condition")
+		}
+	}
+}
+
+// flightCallers will return the number of callers sharing in-flight requests
+func flightCallers(client *Client) (callers int) {
+	client.flights.lock.Lock()
+	defer client.flights.lock.Unlock()
+	for _, current := range client.flights.flights {
+		callers += current.callers
+	}
+	return
+}
+
+// TestClientOptions_SingleFlight tests sharing identical concurrent GET requests
+func TestClientOptions_SingleFlight(t *testing.T) {
+	t.Parallel()
+
+	// getContacts will fire the same request from many goroutines, then open the gate once ready
+	getContacts := func(client *Client, mock *mockHTTPGated, id string, ready func() bool) []*RequestResponse {
+		responses := make([]*RequestResponse, 10)
+		var wg sync.WaitGroup
+		for i := range responses {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				responses[i], _ = client.GetContactsRaw(context.Background(), &ContactQuery{ID: id})
+			}(i)
+		}
+		waitUntil(t, ready)
+		close(mock.gate)
+		wg.Wait()
+		return responses
+	}
+
+	t.Run("identical requests share one call", func(t *testing.T) {
+		mock := &mockHTTPGated{gate: make(chan struct{})}
+		client := newTestClient(mock)
+		client.Options.SingleFlight = true
+
+		responses := getContacts(client, mock, testContactID, func() bool { return flightCallers(client) == 10 })
+		assert.Equal(t, int32(1), atomic.LoadInt32(&mock.calls))
+		for _, response := range responses[1:] {
+			assert.NoError(t, response.Error)
+			assert.Equal(t, http.StatusOK, response.StatusCode)
+			assert.Equal(t, responses[0].BodyContents, response.BodyContents)
+			assert.NotSame(t, responses[0], response)
+		}
+	})
+
+	t.Run("errors are shared", func(t *testing.T) {
+		mock := &mockHTTPGated{gate: make(chan struct{})}
+		client := newTestClient(mock)
+		client.Options.SingleFlight = true
+
+		responses := getContacts(client, mock, testContactIDBadRequest, func() bool { return flightCallers(client) == 10 })
+		assert.Equal(t, int32(1), atomic.LoadInt32(&mock.calls))
+		for _, response := range responses {
+			assert.ErrorIs(t, response.Error, ErrBadRequest)
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		mock := &mockHTTPGated{gate: make(chan struct{})}
+		client := newTestClient(mock)
+
+		_ = getContacts(client, mock, testContactID, func() bool { return atomic.LoadInt32(&mock.calls) == 10 })
+		assert.Equal(t, int32(10), atomic.LoadInt32(&mock.calls))
+	})
+
+	t.Run("not shared with a request modifier", func(t *testing.T) {
+		mock := &mockHTTPGated{gate: make(chan struct{})}
+		client := newTestClient(mock)
+		client.Options.SingleFlight = true
+		client.Options.RequestModifier = func(_ context.Context, _ *http.Request) error { return nil }
+
+		_ = getContacts(client, mock, testContactID, func() bool { return atomic.LoadInt32(&mock.calls) == 10 })
+		assert.Equal(t, int32(10), atomic.LoadInt32(&mock.calls))
+	})
+
+	t.Run("finished requests are not reused", func(t *testing.T) {
+		mock := &mockHTTPGated{gate: make(chan struct{})}
+		close(mock.gate)
+		client := newTestClient(mock)
+		client.Options.SingleFlight = true
+
+		for i := 0; i < 3; i++ {
+			_, err := client.GetContactsRaw(context.Background(), &ContactQuery{ID: testContactID})
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, int32(3), atomic.LoadInt32(&mock.calls))
+	})
+
+	t.Run("a caller can give up without failing the others", func(t *testing.T) {
+		mock := &mockHTTPGated{gate: make(chan struct{})}
+		client := newTestClient(mock)
+		client.Options.SingleFlight = true
+
+		// The first caller starts the request and waits
+		first := make(chan *RequestResponse)
+		go func() {
+			response, _ := client.GetContactsRaw(context.Background(), &ContactQuery{ID: testContactID})
+			first <- response
+		}()
+		waitUntil(t, func() bool { return atomic.LoadInt32(&mock.calls) == 1 })
+
+		// Another caller joins, then gives up
+		ctx, cancel := context.WithCancel(context.Background())
+		second := make(chan *RequestResponse)
+		go func() {
+			response, _ := client.GetContactsRaw(ctx, &ContactQuery{ID: testContactID})
+			second <- response
+		}()
+		waitUntil(t, func() bool { return flightCallers(client) == 2 })
+		cancel()
+		response := <-second
+		assert.ErrorIs(t, response.Error, context.Canceled)
+		assert.Equal(t, apiEndpoint+"/contacts/"+testContactID, response.URL)
+		assert.Equal(t, 1, flightCallers(client))
+
+		// A caller with an expired deadline returns right away
+		expired, cancelExpired := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancelExpired()
+		response, err := client.GetContactsRaw(expired, &ContactQuery{ID: testContactID})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorIs(t, response.Error, context.DeadlineExceeded)
+		assert.Equal(t, 1, flightCallers(client))
+
+		// The request was never canceled for the first caller
+		close(mock.gate)
+		response = <-first
+		assert.NoError(t, response.Error)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&mock.calls))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&mock.canceled))
+	})
+
+	t.Run("the request is canceled once every caller gave up", func(t *testing.T) {
+		mock := &mockHTTPGated{gate: make(chan struct{})}
+		client := newTestClient(mock)
+		client.Options.SingleFlight = true
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			_, err := client.GetContactsRaw(ctx, &ContactQuery{ID: testContactID})
+			done <- err
+		}()
+		waitUntil(t, func() bool { return atomic.LoadInt32(&mock.calls) == 1 })
+		cancel()
+		assert.ErrorIs(t, <-done, context.Canceled)
+		waitUntil(t, func() bool { return atomic.LoadInt32(&mock.canceled) == 1 })
+
+		// The next caller sends a new request
+		close(mock.gate)
+		response, err := client.GetContactsRaw(context.Background(), &ContactQuery{ID: testContactID})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&mock.calls))
+	})
+
+	t.Run("the request is limited by the request timeout", func(t *testing.T) {
+		mock := &mockHTTPGated{gate: make(chan struct{})}
+		client := newTestClient(mock)
+		client.Options.SingleFlight = true
+		client.Options.RequestTimeout = 10 * time.Millisecond
+
+		response, err := client.GetContactsRaw(context.Background(), &ContactQuery{ID: testContactID})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorIs(t, response.Error, context.DeadlineExceeded)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&mock.canceled))
+		assert.Equal(t, 0, flightCallers(client))
+	})
+
+	t.Run("a panic does not block later requests", func(t *testing.T) {
+		mock := &mockHTTPGated{gate: make(chan struct{})}
+		close(mock.gate)
+		client := newTestClient(mock)
+		client.Options.SingleFlight = true
+		client.Options.CurlDebug = func(string) { panic("debug failed") }
+
+		assert.PanicsWithValue(t, "debug failed", func() {
+			_, _ = client.GetContactsRaw(context.Background(), &ContactQuery{ID: testContactID})
+		})
+
+		client.Options.CurlDebug = nil
+		response, err := client.GetContactsRaw(context.Background(), &ContactQuery{ID: testContactID})
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+	})
+}
@@ -2,7 +2,6 @@ package drift
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 )
 
@@ -20,14 +19,30 @@ type TimelineResponse struct {
 	Data *TimelineEvent `json:"data"`
 }
 
+// Validate will check the event before it is created
+func (e *TimelineEvent) Validate() error {
+	if e == nil || e.ContactID == 0 {
+		return ErrMissingContactID
+	}
+	if len(e.Event) == 0 {
+		return ErrMissingEventName
+	}
+	return nil
+}
+
 // CreateTimelineEvent will create a new timeline event
 // specs: https://devdocs.drift.com/docs/posting-timeline-events
 func (c *Client) CreateTimelineEvent(ctx context.Context,
 	event *TimelineEvent) (response *TimelineResponse, err error) {
 
+	// Validate the event
+	if err = event.Validate(); err != nil {
+		return
+	}
+
 	// Marshall the attributes
 	var data []byte
-	if data, err = json.Marshal(event); err != nil {
+	if data, err = Marshaler(event); err != nil {
 		return
 	}
 
@@ -46,6 +61,6 @@ func (c *Client) CreateTimelineEvent(ctx context.Context,
 	}
 
 	// Parse the request
-	err = json.Unmarshal(resp.BodyContents, &response)
+	err = decodeResponse(resp.BodyContents, &response)
 	return
 }
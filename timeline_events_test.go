@@ -61,6 +61,73 @@ func TestClient_CreateTimelineEvent(t *testing.T) {
 		assert.Equal(t, uint64(1614571424495), resp.Data.CreatedAt)
 		assert.Equal(t, id, resp.Data.ContactID)
 	})
+
+	t.Run("missing contact id", func(t *testing.T) {
+		client := newTestClient(&mockHTTPTimelineEvents{})
+
+		resp, err := client.CreateTimelineEvent(
+			context.Background(), &TimelineEvent{
+				Event: testEventName,
+			})
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, ErrMissingContactID)
+	})
+
+	t.Run("missing event name", func(t *testing.T) {
+		client := newTestClient(&mockHTTPTimelineEvents{})
+
+		id, err := strconv.ParseUint(testContactID, 10, 64)
+		assert.NoError(t, err)
+
+		var resp *TimelineResponse
+		resp, err = client.CreateTimelineEvent(
+			context.Background(), &TimelineEvent{
+				ContactID: id,
+			})
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, ErrMissingEventName)
+	})
+
+	t.Run("error responses", func(t *testing.T) {
+		id, err := strconv.ParseUint(testContactID, 10, 64)
+		assert.NoError(t, err)
+
+		tests := []struct {
+			statusCode  int
+			expectedErr error
+		}{
+			{http.StatusBadRequest, ErrBadRequest},
+			{http.StatusUnauthorized, ErrUnauthorized},
+			{http.StatusNotFound, ErrResourceNotFound},
+			{http.StatusInternalServerError, ErrUnexpectedStatus},
+		}
+		for _, test := range tests {
+			client := newTestClient(&mockHTTPResponse{statusCode: test.statusCode})
+
+			var resp *TimelineResponse
+			resp, err = client.CreateTimelineEvent(
+				context.Background(), &TimelineEvent{
+					ContactID: id,
+					Event:     testEventName,
+				})
+			assert.Nil(t, resp)
+			assert.ErrorIs(t, err, test.expectedErr)
+		}
+	})
+
+	t.Run("bad json response", func(t *testing.T) {
+		client := newTestClient(&mockHTTPResponse{body: `{"data":`, statusCode: http.StatusOK})
+
+		id, err := strconv.ParseUint(testContactID, 10, 64)
+		assert.NoError(t, err)
+
+		_, err = client.CreateTimelineEvent(
+			context.Background(), &TimelineEvent{
+				ContactID: id,
+				Event:     testEventName,
+			})
+		assert.ErrorIs(t, err, ErrDecodeResponse)
+	})
 }
 
 // BenchmarkClient_CreateTimelineEvent benchmarks the CreateTimelineEvent method